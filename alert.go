@@ -0,0 +1,133 @@
+package ui
+
+import (
+	x "github.com/plainkit/html"
+	"github.com/plainkit/icons/lucide"
+)
+
+const alertJS = `(function(){
+  document.addEventListener('click', e=>{
+    const btn = e.target.closest && e.target.closest('[data-alert-dismiss]');
+    if(btn) btn.closest('[data-slot="alert"]').remove();
+  });
+})();`
+
+// Internal wrappers embed x.Global so they are valid x.DivArg; variants also carry their
+// default icon and whether they interrupt (role="alert") or wait (role="status")
+type alertVariantArg struct {
+	x.Global
+	icon   func() x.Node
+	urgent bool
+}
+
+type alertIconArg struct {
+	x.Global
+	icon x.Node
+}
+
+type alertDismissibleArg struct{ x.Global }
+
+// Variants
+
+func AlertDefault() x.DivArg {
+	s := "bg-card text-card-foreground"
+	return alertVariantArg{Global: x.Class(s)}
+}
+
+func AlertDestructive() x.DivArg {
+	s := "bg-card text-destructive border-destructive/50 [&>svg]:text-current *:data-[slot=alert-description]:text-destructive/90"
+	return alertVariantArg{Global: x.Class(s), icon: func() x.Node { return lucide.CircleAlert() }, urgent: true}
+}
+
+func AlertWarning() x.DivArg {
+	s := "border-amber-500/50 bg-amber-50 text-amber-900 dark:bg-amber-950/50 dark:text-amber-200 *:data-[slot=alert-description]:text-amber-900/90 dark:*:data-[slot=alert-description]:text-amber-200/90"
+	return alertVariantArg{Global: x.Class(s), icon: func() x.Node { return lucide.TriangleAlert() }, urgent: true}
+}
+
+func AlertSuccess() x.DivArg {
+	s := "border-emerald-500/50 bg-emerald-50 text-emerald-900 dark:bg-emerald-950/50 dark:text-emerald-200 *:data-[slot=alert-description]:text-emerald-900/90 dark:*:data-[slot=alert-description]:text-emerald-200/90"
+	return alertVariantArg{Global: x.Class(s), icon: func() x.Node { return lucide.CircleCheck() }}
+}
+
+func AlertInfo() x.DivArg {
+	s := "border-sky-500/50 bg-sky-50 text-sky-900 dark:bg-sky-950/50 dark:text-sky-200 *:data-[slot=alert-description]:text-sky-900/90 dark:*:data-[slot=alert-description]:text-sky-200/90"
+	return alertVariantArg{Global: x.Class(s), icon: func() x.Node { return lucide.Info() }}
+}
+
+// AlertIcon replaces the variant's leading icon (the default variant has none)
+func AlertIcon(icon x.Node) x.DivArg {
+	return alertIconArg{Global: x.Class(""), icon: icon}
+}
+
+// AlertDismissible adds a close button that removes the alert
+func AlertDismissible() x.DivArg {
+	return alertDismissibleArg{Global: x.Class("pr-10")}
+}
+
+// Alert renders a callout with an optional leading icon. Destructive and warning alerts use
+// role="alert" so assistive tech announces them immediately; the rest use role="status".
+// Pass AlertTitle/AlertDescription via x.Child.
+func Alert(args ...x.DivArg) x.Node {
+	classes := "relative grid w-full grid-cols-[0_1fr] items-start gap-y-0.5 rounded-lg border px-4 py-3 text-sm has-[>svg]:grid-cols-[calc(var(--spacing)*4)_1fr] has-[>svg]:gap-x-3 [&>svg]:size-4 [&>svg]:translate-y-0.5 [&>svg]:text-current"
+	alertArgs := []x.DivArg{x.Class(classes), x.Data("slot", "alert")}
+
+	var variant *alertVariantArg
+	var icon x.Node
+	var hasIcon, dismissible bool
+	for _, a := range args {
+		switch v := a.(type) {
+		case alertVariantArg:
+			if variant == nil {
+				variant = &v
+			}
+		case alertIconArg:
+			icon, hasIcon = v.icon, true
+		case alertDismissibleArg:
+			dismissible = true
+		}
+	}
+	if variant == nil {
+		v := AlertDefault().(alertVariantArg)
+		variant = &v
+		alertArgs = append(alertArgs, v)
+	}
+	if !hasIcon && variant.icon != nil {
+		icon, hasIcon = variant.icon(), true
+	}
+
+	role := "status"
+	if variant.urgent {
+		role = "alert"
+	}
+	alertArgs = append(alertArgs, x.Role(role))
+	if hasIcon {
+		alertArgs = append(alertArgs, x.Child(icon))
+	}
+	alertArgs = append(alertArgs, args...)
+
+	if !dismissible {
+		return x.Div(alertArgs...)
+	}
+	alertArgs = append(alertArgs, x.Child(x.Button(
+		x.Class("absolute top-2.5 right-2.5 rounded-md p-1 opacity-70 transition-opacity hover:opacity-100 focus-visible:outline-none focus-visible:ring-1 focus-visible:ring-ring"),
+		x.ButtonType("button"),
+		x.Aria("label", "Dismiss"),
+		x.Data("alert-dismiss", ""),
+		x.Child(lucide.X(lucide.Size("14"))),
+	)))
+	return x.Div(alertArgs...).WithAssets("", alertJS, "alert")
+}
+
+// AlertTitle renders the alert heading
+func AlertTitle(args ...x.DivArg) x.Node {
+	classes := "col-start-2 line-clamp-1 min-h-4 font-medium tracking-tight"
+	titleArgs := append([]x.DivArg{x.Class(classes), x.Data("slot", "alert-title")}, args...)
+	return x.Div(titleArgs...)
+}
+
+// AlertDescription renders the alert body
+func AlertDescription(args ...x.DivArg) x.Node {
+	classes := "col-start-2 grid justify-items-start gap-1 text-sm text-muted-foreground [&_p]:leading-relaxed"
+	descArgs := append([]x.DivArg{x.Class(classes), x.Data("slot", "alert-description")}, args...)
+	return x.Div(descArgs...)
+}
@@ -0,0 +1,208 @@
+package ui
+
+import (
+	"hash/fnv"
+	"strconv"
+	"strings"
+	"unicode"
+
+	x "github.com/plainkit/html"
+)
+
+// avatarJS reveals the fallback under an image that can't be loaded. error doesn't bubble, so it
+// listens in the capture phase; images that failed before the script ran are removed at init.
+const avatarJS = `(function(){
+  const broken = img => img.complete && img.naturalWidth===0;
+  document.addEventListener('error', e=>{
+    const img = e.target;
+    if(img.matches && img.matches('[data-slot="avatar-image"]')) img.remove();
+  }, true);
+
+  function init(){ document.querySelectorAll('[data-slot="avatar-image"]').forEach(img=>{ if(broken(img)) img.remove(); }); }
+  if(document.readyState==='loading'){
+    document.addEventListener('DOMContentLoaded', init);
+  } else {
+    init();
+  }
+})();`
+
+// Internal wrappers embed x.Global so they are valid x.SpanArg and keep their class string
+type avatarSizeArg struct {
+	x.Global
+	cls string
+}
+
+type avatarStatusArg struct {
+	x.Global
+	cls, label string
+}
+
+// Sizes (prefixed)
+
+func AvatarDefaultSize() x.SpanArg {
+	s := "size-8 [&_[data-slot=avatar-fallback]]:text-xs"
+	return avatarSizeArg{Global: x.Class(s), cls: s}
+}
+
+func AvatarSm() x.SpanArg {
+	s := "size-6 [&_[data-slot=avatar-fallback]]:text-[10px]"
+	return avatarSizeArg{Global: x.Class(s), cls: s}
+}
+
+func AvatarLg() x.SpanArg {
+	s := "size-12 [&_[data-slot=avatar-fallback]]:text-base"
+	return avatarSizeArg{Global: x.Class(s), cls: s}
+}
+
+// Status indicators
+
+func AvatarOnline() x.SpanArg {
+	return avatarStatusArg{Global: x.Class(""), cls: "bg-emerald-500", label: "Online"}
+}
+
+func AvatarAway() x.SpanArg {
+	return avatarStatusArg{Global: x.Class(""), cls: "bg-amber-500", label: "Away"}
+}
+
+func AvatarBusy() x.SpanArg {
+	return avatarStatusArg{Global: x.Class(""), cls: "bg-red-500", label: "Busy"}
+}
+
+func AvatarOffline() x.SpanArg {
+	return avatarStatusArg{Global: x.Class(""), cls: "bg-muted-foreground", label: "Offline"}
+}
+
+// Avatar renders a round container; pass AvatarImage and AvatarFallback via x.Child.
+// The image sits over the fallback and removes itself if it fails to load.
+func Avatar(args ...x.SpanArg) x.Node {
+	avatarArgs := []x.SpanArg{
+		x.Class("relative inline-flex shrink-0 select-none rounded-full"),
+		x.Data("slot", "avatar"),
+	}
+
+	var hasSize bool
+	var status *avatarStatusArg
+	for _, a := range args {
+		switch v := a.(type) {
+		case avatarSizeArg:
+			hasSize = true
+		case avatarStatusArg:
+			status = &v
+		}
+	}
+	if !hasSize {
+		avatarArgs = append(avatarArgs, AvatarDefaultSize())
+	}
+	avatarArgs = append(avatarArgs, args...)
+	if status != nil {
+		avatarArgs = append(avatarArgs, x.Child(x.Span(
+			x.Class("absolute right-0 bottom-0 z-10 size-[28%] min-w-2 min-h-2 rounded-full ring-2 ring-background "+status.cls),
+			x.Role("img"),
+			x.Aria("label", status.label),
+			x.Data("slot", "avatar-status"),
+		)))
+	}
+
+	return x.Span(avatarArgs...)
+}
+
+// AvatarImage renders the picture; alt should name the person
+func AvatarImage(src, alt string, args ...x.ImgArg) x.Node {
+	imgArgs := []x.ImgArg{
+		x.Class("absolute inset-0 z-[1] size-full rounded-full object-cover"),
+		x.Src(src),
+		x.Alt(alt),
+		x.Data("slot", "avatar-image"),
+	}
+	imgArgs = append(imgArgs, args...)
+	return x.Img(imgArgs...).WithAssets("", avatarJS, "avatar")
+}
+
+// avatarColors are picked by name so the same person always gets the same color
+var avatarColors = []string{
+	"bg-red-500 text-white",
+	"bg-orange-500 text-white",
+	"bg-amber-500 text-white",
+	"bg-emerald-500 text-white",
+	"bg-teal-500 text-white",
+	"bg-sky-500 text-white",
+	"bg-indigo-500 text-white",
+	"bg-violet-500 text-white",
+	"bg-pink-500 text-white",
+}
+
+// AvatarFallback shows the initials of name on a color derived from name.
+// Pass an empty name for a neutral muted fallback and supply content via x.Child/x.T.
+func AvatarFallback(name string, args ...x.SpanArg) x.Node {
+	color := "bg-muted text-muted-foreground"
+	if name != "" {
+		h := fnv.New32a()
+		h.Write([]byte(name))
+		color = avatarColors[h.Sum32()%uint32(len(avatarColors))]
+	}
+
+	fallbackArgs := []x.SpanArg{
+		x.Class("flex size-full items-center justify-center rounded-full font-medium " + color),
+		x.Data("slot", "avatar-fallback"),
+	}
+	if name != "" {
+		fallbackArgs = append(fallbackArgs, x.Aria("hidden", "true"), x.T(AvatarInitials(name)))
+	}
+	fallbackArgs = append(fallbackArgs, args...)
+	return x.Span(fallbackArgs...)
+}
+
+// AvatarInitials returns up to two uppercase initials: the first letters of the first and
+// last words of name ("Ada Lovelace" → "AL", "ada.lovelace@example.com" → "AL")
+func AvatarInitials(name string) string {
+	if at := strings.IndexByte(name, '@'); at > 0 {
+		name = name[:at]
+	}
+	words := strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if len(words) == 0 {
+		return ""
+	}
+
+	first := func(w string) string {
+		for _, r := range w {
+			return string(unicode.ToUpper(r))
+		}
+		return ""
+	}
+	initials := first(words[0])
+	if len(words) > 1 {
+		initials += first(words[len(words)-1])
+	}
+	return initials
+}
+
+// AvatarGroup overlaps avatars and collapses those beyond max into a "+N" bubble (max <= 0 shows all)
+func AvatarGroup(avatars []x.Node, max int, args ...x.DivArg) x.Node {
+	groupArgs := []x.DivArg{
+		x.Class("flex items-stretch -space-x-2 *:ring-2 *:ring-background"),
+		x.Data("slot", "avatar-group"),
+	}
+
+	shown := avatars
+	if max > 0 && len(avatars) > max {
+		shown = avatars[:max]
+	}
+	for _, a := range shown {
+		groupArgs = append(groupArgs, x.Child(a))
+	}
+	if rest := len(avatars) - len(shown); rest > 0 {
+		// stretches to the avatars' height and stays square, whatever size they use
+		groupArgs = append(groupArgs, x.Child(x.Span(
+			x.Class("relative flex aspect-square items-center justify-center rounded-full bg-muted text-xs font-medium text-muted-foreground"),
+			x.Role("img"),
+			x.Aria("label", strconv.Itoa(rest)+" more"),
+			x.Data("slot", "avatar-group-overflow"),
+			x.T("+"+strconv.Itoa(rest)),
+		)))
+	}
+	groupArgs = append(groupArgs, args...)
+
+	return x.Div(groupArgs...)
+}
@@ -0,0 +1,141 @@
+package ui
+
+import (
+	"strconv"
+
+	x "github.com/plainkit/html"
+)
+
+// badgeBase leaves out radius and horizontal padding, which differ between labels and counts
+const badgeBase = "inline-flex w-fit shrink-0 items-center justify-center gap-1 overflow-hidden whitespace-nowrap border py-0.5 text-xs font-medium transition-[color,box-shadow] focus-visible:border-ring focus-visible:ring-[3px] focus-visible:ring-ring/50 [&>svg]:pointer-events-none [&>svg]:size-3"
+
+// Internal wrappers embed x.Global so they are valid x.SpanArg and keep their class string
+type badgeVariantArg struct {
+	x.Global
+	cls string
+}
+
+type badgeDotArg struct{ x.Global }
+
+type badgeCountArg struct {
+	x.Global
+	n, max int
+}
+
+// Variants
+
+func BadgeDefault() x.SpanArg {
+	s := "border-transparent bg-primary text-primary-foreground [a&]:hover:bg-primary/90"
+	return badgeVariantArg{Global: x.Class(s), cls: s}
+}
+
+func BadgeSecondary() x.SpanArg {
+	s := "border-transparent bg-secondary text-secondary-foreground [a&]:hover:bg-secondary/90"
+	return badgeVariantArg{Global: x.Class(s), cls: s}
+}
+
+func BadgeDestructive() x.SpanArg {
+	s := "border-transparent bg-destructive text-white [a&]:hover:bg-destructive/90"
+	return badgeVariantArg{Global: x.Class(s), cls: s}
+}
+
+func BadgeOutline() x.SpanArg {
+	s := "text-foreground [a&]:hover:bg-accent [a&]:hover:text-accent-foreground"
+	return badgeVariantArg{Global: x.Class(s), cls: s}
+}
+
+func BadgeBlue() x.SpanArg {
+	s := "border-transparent bg-blue-100 text-blue-800 dark:bg-blue-950 dark:text-blue-300 [a&]:hover:bg-blue-200 dark:[a&]:hover:bg-blue-900"
+	return badgeVariantArg{Global: x.Class(s), cls: s}
+}
+
+func BadgeGreen() x.SpanArg {
+	s := "border-transparent bg-emerald-100 text-emerald-800 dark:bg-emerald-950 dark:text-emerald-300 [a&]:hover:bg-emerald-200 dark:[a&]:hover:bg-emerald-900"
+	return badgeVariantArg{Global: x.Class(s), cls: s}
+}
+
+func BadgeYellow() x.SpanArg {
+	s := "border-transparent bg-amber-100 text-amber-800 dark:bg-amber-950 dark:text-amber-300 [a&]:hover:bg-amber-200 dark:[a&]:hover:bg-amber-900"
+	return badgeVariantArg{Global: x.Class(s), cls: s}
+}
+
+func BadgeRed() x.SpanArg {
+	s := "border-transparent bg-red-100 text-red-800 dark:bg-red-950 dark:text-red-300 [a&]:hover:bg-red-200 dark:[a&]:hover:bg-red-900"
+	return badgeVariantArg{Global: x.Class(s), cls: s}
+}
+
+func BadgePurple() x.SpanArg {
+	s := "border-transparent bg-violet-100 text-violet-800 dark:bg-violet-950 dark:text-violet-300 [a&]:hover:bg-violet-200 dark:[a&]:hover:bg-violet-900"
+	return badgeVariantArg{Global: x.Class(s), cls: s}
+}
+
+// Modes
+
+// BadgeDot adds a leading status dot in the badge's text color
+func BadgeDot() x.SpanArg {
+	return badgeDotArg{Global: x.Class("")}
+}
+
+// BadgeCount renders n as a pill, showing "max+" above max (99 when max <= 0)
+func BadgeCount(n, max int) x.SpanArg {
+	if max <= 0 {
+		max = 99
+	}
+	return badgeCountArg{Global: x.Class(""), n: n, max: max}
+}
+
+// Badge renders a small label. Strictly accepts x.SpanArg; applies BadgeDefault when no variant is given.
+func Badge(args ...x.SpanArg) x.Node {
+	badgeArgs := []x.SpanArg{x.Class(badgeBase), x.Data("slot", "badge")}
+
+	var hasVariant, dot bool
+	var count *badgeCountArg
+	for _, a := range args {
+		switch v := a.(type) {
+		case badgeVariantArg:
+			hasVariant = true
+		case badgeDotArg:
+			dot = true
+		case badgeCountArg:
+			count = &v
+		}
+	}
+	if !hasVariant {
+		badgeArgs = append(badgeArgs, BadgeDefault())
+	}
+	if count != nil {
+		badgeArgs = append(badgeArgs, x.Class("min-w-5 rounded-full px-1 tabular-nums"))
+	} else {
+		badgeArgs = append(badgeArgs, x.Class("rounded-md px-2"))
+	}
+	if dot {
+		badgeArgs = append(badgeArgs, x.Child(x.Span(x.Class("size-1.5 rounded-full bg-current"), x.Aria("hidden", "true"))))
+	}
+	badgeArgs = append(badgeArgs, args...)
+	if count != nil {
+		badgeArgs = append(badgeArgs, x.T(badgeCountText(count.n, count.max)))
+	}
+
+	return x.Span(badgeArgs...)
+}
+
+func badgeCountText(n, max int) string {
+	if n > max {
+		return strconv.Itoa(max) + "+"
+	}
+	return strconv.Itoa(n)
+}
+
+// BadgeClass returns a single x.Class with base + variant classes; useful for asChild-like usage on links
+func BadgeClass(args ...x.SpanArg) x.Global {
+	var variantCls string
+	for _, a := range args {
+		if v, ok := a.(badgeVariantArg); ok && variantCls == "" {
+			variantCls = v.cls
+		}
+	}
+	if variantCls == "" {
+		variantCls = BadgeDefault().(badgeVariantArg).cls
+	}
+	return x.Class(badgeBase + " rounded-md px-2 " + variantCls)
+}
@@ -0,0 +1,191 @@
+package ui
+
+import (
+	"net/url"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	x "github.com/plainkit/html"
+	"github.com/plainkit/icons/lucide"
+)
+
+// Breadcrumb renders the navigation landmark; pass a BreadcrumbList via x.Child
+func Breadcrumb(args ...x.NavArg) x.Node {
+	navArgs := []x.NavArg{x.Aria("label", "breadcrumb"), x.Data("slot", "breadcrumb")}
+	navArgs = append(navArgs, args...)
+	return x.Nav(navArgs...)
+}
+
+// BreadcrumbList renders the ordered list of items and separators
+func BreadcrumbList(args ...x.OlArg) x.Node {
+	classes := "flex flex-wrap items-center gap-1.5 break-words text-sm text-muted-foreground sm:gap-2.5"
+	listArgs := append([]x.OlArg{x.Class(classes), x.Data("slot", "breadcrumb-list")}, args...)
+	return x.Ol(listArgs...)
+}
+
+// BreadcrumbItem wraps a BreadcrumbLink, BreadcrumbPage or BreadcrumbEllipsis
+func BreadcrumbItem(args ...x.LiArg) x.Node {
+	itemArgs := append([]x.LiArg{x.Class("inline-flex items-center gap-1.5"), x.Data("slot", "breadcrumb-item")}, args...)
+	return x.Li(itemArgs...)
+}
+
+// BreadcrumbLink renders an ancestor page link
+func BreadcrumbLink(href string, args ...x.AArg) x.Node {
+	linkArgs := []x.AArg{
+		x.Class("transition-colors hover:text-foreground"),
+		x.Href(href),
+		x.Data("slot", "breadcrumb-link"),
+	}
+	linkArgs = append(linkArgs, args...)
+	return x.A(linkArgs...)
+}
+
+// BreadcrumbPage renders the current page with aria-current="page"
+func BreadcrumbPage(args ...x.SpanArg) x.Node {
+	pageArgs := []x.SpanArg{
+		x.Class("font-normal text-foreground"),
+		x.Aria("current", "page"),
+		x.Data("slot", "breadcrumb-page"),
+	}
+	pageArgs = append(pageArgs, args...)
+	return x.Span(pageArgs...)
+}
+
+// BreadcrumbSeparator renders a chevron between items; pass x.Child to use another glyph
+func BreadcrumbSeparator(args ...x.LiArg) x.Node {
+	sepArgs := []x.LiArg{
+		x.Class("[&>svg]:size-3.5"),
+		x.Role("presentation"),
+		x.Aria("hidden", "true"),
+		x.Data("slot", "breadcrumb-separator"),
+	}
+	if len(args) == 0 {
+		sepArgs = append(sepArgs, x.Child(lucide.ChevronRight()))
+	}
+	sepArgs = append(sepArgs, args...)
+	return x.Li(sepArgs...)
+}
+
+// BreadcrumbEllipsis marks collapsed items
+func BreadcrumbEllipsis(args ...x.SpanArg) x.Node {
+	ellipsisArgs := []x.SpanArg{
+		x.Class("flex size-9 items-center justify-center [&>svg]:size-4"),
+		x.Data("slot", "breadcrumb-ellipsis"),
+		x.Child(lucide.Ellipsis()),
+		x.Child(x.Span(x.Class("sr-only"), x.T("More"))),
+	}
+	ellipsisArgs = append(ellipsisArgs, args...)
+	return x.Span(ellipsisArgs...)
+}
+
+// Crumb is one step of a breadcrumb trail
+type Crumb struct {
+	Label string
+	Href  string
+}
+
+// BreadcrumbCrumbs splits a URL path into crumbs, starting with "/" as Home.
+// label names each crumb from its decoded segment and href ("" for the root);
+// when label is nil or returns "", the segment is humanized ("user-settings" → "User settings").
+func BreadcrumbCrumbs(path string, label func(segment, href string) string) []Crumb {
+	crumbs := []Crumb{{Label: breadcrumbLabel(label, "", "/"), Href: "/"}}
+	href := ""
+	for _, seg := range strings.Split(strings.Trim(path, "/"), "/") {
+		if seg == "" {
+			continue
+		}
+		href += "/" + seg
+		if s, err := url.PathUnescape(seg); err == nil {
+			seg = s
+		}
+		crumbs = append(crumbs, Crumb{Label: breadcrumbLabel(label, seg, href), Href: href})
+	}
+	return crumbs
+}
+
+func breadcrumbLabel(label func(segment, href string) string, segment, href string) string {
+	if label != nil {
+		if l := label(segment, href); l != "" {
+			return l
+		}
+	}
+	if segment == "" {
+		return "Home"
+	}
+	s := strings.Join(strings.FieldsFunc(segment, func(r rune) bool { return r == '-' || r == '_' }), " ")
+	if s == "" {
+		// nothing but separators ("---"); show the segment as it is
+		return segment
+	}
+	r, n := utf8.DecodeRuneInString(s)
+	return string(unicode.ToUpper(r)) + s[n:]
+}
+
+// BreadcrumbFromPath renders a Breadcrumb for path (see BreadcrumbCrumbs); the last crumb is the
+// current page. When there are more than maxItems crumbs (maxItems <= 0 shows all), the middle
+// ones collapse into an ellipsis that opens a list of the hidden links. The ellipsis counts as an
+// item and the root and current page are always shown, so maxItems below 3 is treated as 3.
+func BreadcrumbFromPath(path string, label func(segment, href string) string, maxItems int, args ...x.NavArg) x.Node {
+	return BreadcrumbTrail(BreadcrumbCrumbs(path, label), maxItems, args...)
+}
+
+// BreadcrumbTrail renders a Breadcrumb for crumbs, collapsing as BreadcrumbFromPath does
+func BreadcrumbTrail(crumbs []Crumb, maxItems int, args ...x.NavArg) x.Node {
+	head, hidden, tail := crumbs, []Crumb(nil), []Crumb(nil)
+	if maxItems > 0 && maxItems < 3 {
+		maxItems = 3
+	}
+	if maxItems > 0 && len(crumbs) > maxItems {
+		// keep the root, the ellipsis and the last maxItems-2 crumbs
+		keep := maxItems - 2
+		head, hidden, tail = crumbs[:1], crumbs[1:len(crumbs)-keep], crumbs[len(crumbs)-keep:]
+	}
+
+	var listArgs []x.OlArg
+	add := func(item x.Node) {
+		if len(listArgs) > 0 {
+			listArgs = append(listArgs, x.Child(BreadcrumbSeparator()))
+		}
+		listArgs = append(listArgs, x.Child(item))
+	}
+	visible := append(append([]Crumb{}, head...), tail...)
+	for i, c := range visible {
+		if i == len(head) && len(hidden) > 0 {
+			add(BreadcrumbItem(x.Child(breadcrumbOverflow(hidden))))
+		}
+		if i == len(visible)-1 {
+			add(BreadcrumbItem(x.Child(BreadcrumbPage(x.T(c.Label)))))
+		} else {
+			add(BreadcrumbItem(x.Child(BreadcrumbLink(c.Href, x.T(c.Label)))))
+		}
+	}
+
+	return Breadcrumb(append([]x.NavArg{x.Child(BreadcrumbList(listArgs...))}, args...)...)
+}
+
+// breadcrumbOverflow is a disclosure listing the collapsed crumbs; it works without JS
+func breadcrumbOverflow(hidden []Crumb) x.Node {
+	menu := []x.UlArg{
+		x.Class("absolute top-full left-0 z-50 mt-1 grid min-w-32 rounded-md border bg-popover p-1 text-popover-foreground shadow-md"),
+		x.Data("slot", "breadcrumb-overflow-menu"),
+	}
+	for _, c := range hidden {
+		menu = append(menu, x.Child(x.Li(x.Child(x.A(
+			x.Class("block rounded-sm px-2 py-1.5 text-sm hover:bg-accent hover:text-accent-foreground focus-visible:bg-accent focus-visible:outline-none"),
+			x.Href(c.Href),
+			x.T(c.Label),
+		)))))
+	}
+
+	return x.Details(
+		x.Class("relative"),
+		x.Data("slot", "breadcrumb-overflow"),
+		x.Child(x.Summary(
+			x.Class("list-none rounded-md transition-colors hover:text-foreground focus-visible:outline-none focus-visible:ring-[3px] focus-visible:ring-ring/50 [&::-webkit-details-marker]:hidden"),
+			x.Aria("label", "Show hidden breadcrumbs"),
+			x.Child(BreadcrumbEllipsis()),
+		)),
+		x.Child(x.Ul(menu...)),
+	)
+}
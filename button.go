@@ -1,9 +1,33 @@
 package ui
 
 import (
+	"strings"
+
 	x "github.com/plainkit/html"
+	"github.com/plainkit/icons/lucide"
 )
 
+const buttonLoadingJS = `(function(){
+  function setLoading(btn, on){
+    if(on){ btn.dataset.loading = 'true'; btn.setAttribute('aria-busy', 'true'); btn.disabled = true; }
+    else { delete btn.dataset.loading; btn.removeAttribute('aria-busy'); btn.disabled = false; }
+  }
+
+  // bubble phase, so handlers that cancel the submit (client validation, fetch forms) run first
+  document.addEventListener('submit', e=>{
+    if(e.defaultPrevented) return;
+    const btn = e.submitter && e.submitter.matches('[data-loading-on-submit]') ? e.submitter : e.target.querySelector('button[data-loading-on-submit]');
+    if(!btn) return;
+    // disabling synchronously would drop the submitter's name/value from the posted data
+    setTimeout(()=>setLoading(btn, true));
+  });
+
+  // pages restored from the back/forward cache come back with the button still spinning
+  window.addEventListener('pageshow', e=>{
+    if(e.persisted) document.querySelectorAll('button[data-loading-on-submit][data-loading]').forEach(b=>setLoading(b, false));
+  });
+})();`
+
 // Base button classes (shadcn/ui parity)
 func buttonBase() x.ButtonArg {
 	return x.Class("inline-flex items-center justify-center gap-2 whitespace-nowrap rounded-md text-sm font-medium transition-colors focus-visible:outline-none focus-visible:ring-1 focus-visible:ring-ring disabled:pointer-events-none disabled:opacity-50 [&_svg]:pointer-events-none [&_svg]:size-4 [&_svg]:shrink-0")
@@ -20,6 +44,11 @@ type buttonSizeArg struct {
 	cls string
 }
 
+type buttonLoadingArg struct {
+	x.Global
+	onSubmit bool
+}
+
 // Variants
 
 func ButtonDefault() x.ButtonArg {
@@ -94,31 +123,90 @@ func ButtonIcon() x.ButtonArg {
 	return buttonSizeArg{Global: x.Class(s), cls: s}
 }
 
+// Loading state
+
+// ButtonLoading renders the button busy: disabled, with a spinner over its content
+func ButtonLoading() x.ButtonArg {
+	return buttonLoadingArg{Global: x.Data("loading", "true")}
+}
+
+// ButtonLoadingOnSubmit switches the button to the loading state when its form submits
+// (pair it with x.ButtonType("submit")). The content stays in place, invisible, so the
+// button keeps its width.
+func ButtonLoadingOnSubmit() x.ButtonArg {
+	return buttonLoadingArg{Global: x.Data("loading-on-submit", ""), onSubmit: true}
+}
+
+// buttonTextClasses picks the text color utilities out of a variant so the spinner stays
+// visible while the label is made transparent
+func buttonTextClasses(cls string) string {
+	var out []string
+	for _, c := range strings.Fields(cls) {
+		if strings.HasPrefix(c, "text-") || strings.HasPrefix(c, "dark:text-") {
+			out = append(out, c)
+		}
+	}
+	if len(out) == 0 {
+		return "text-foreground"
+	}
+	return strings.Join(out, " ")
+}
+
 // Button creates a UI button with styling. Strictly accepts x.ButtonArg values.
 // Adds base classes and applies default variant/size if not provided.
 func Button(args ...x.ButtonArg) x.Component {
 	buttonArgs := make([]x.ButtonArg, 0, len(args)+3)
 	buttonArgs = append(buttonArgs, buttonBase())
 
-	var hasVariant, hasSize bool
+	var hasVariant, hasSize, loading, loadingOnSubmit bool
+	variantCls := ""
 	for _, a := range args {
-		switch a.(type) {
+		switch v := a.(type) {
 		case buttonVariantArg:
 			hasVariant = true
+			if variantCls == "" {
+				variantCls = v.cls
+			}
 		case buttonSizeArg:
 			hasSize = true
+		case buttonLoadingArg:
+			if v.onSubmit {
+				loadingOnSubmit = true
+			} else {
+				loading = true
+			}
 		}
 		buttonArgs = append(buttonArgs, a)
 	}
 
 	if !hasVariant {
 		buttonArgs = append(buttonArgs, ButtonDefault())
+		variantCls = ButtonDefault().(buttonVariantArg).cls
 	}
 	if !hasSize {
 		buttonArgs = append(buttonArgs, ButtonDefaultSize())
 	}
+	if !loading && !loadingOnSubmit {
+		return x.Button(buttonArgs...)
+	}
 
-	return x.Button(buttonArgs...)
+	// Loading: hide the content but keep its box, and center a spinner over it
+	buttonArgs = append(buttonArgs,
+		x.Class("relative data-[loading=true]:text-transparent data-[loading=true]:[&>*:not([data-slot=button-spinner])]:invisible [&[data-loading=true]>[data-slot=button-spinner]]:flex"),
+		x.Child(x.Span(
+			x.Class("absolute inset-0 hidden items-center justify-center "+buttonTextClasses(variantCls)),
+			x.Aria("hidden", "true"),
+			x.Data("slot", "button-spinner"),
+			x.Child(lucide.LoaderCircle(x.Class("animate-spin motion-reduce:animate-none"))),
+		)),
+	)
+	if loading {
+		buttonArgs = append(buttonArgs, x.Disabled(), x.Aria("busy", "true"))
+	}
+	if !loadingOnSubmit {
+		return x.Button(buttonArgs...)
+	}
+	return x.Button(buttonArgs...).WithAssets("", buttonLoadingJS, "button-loading")
 }
 
 // ButtonClass returns a single x.Class with base + variant + size classes; useful for asChild-like usage
@@ -0,0 +1,426 @@
+package ui
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	x "github.com/plainkit/html"
+	"github.com/plainkit/icons/lucide"
+)
+
+const calendarJS = `(function(){
+  // roving tabindex: the grid is a single tab stop, on the day focused last
+  document.addEventListener('focusin', e=>{
+    const day = e.target.closest && e.target.closest('[data-slot="calendar"] :is(a,button)[data-day]');
+    if(!day) return;
+    day.closest('tbody').querySelectorAll(':is(a,button)[data-day]').forEach(d=>{ d.tabIndex = d===day? 0 : -1; });
+  });
+
+  // grid keys for link calendars; the date picker asset handles its own button calendars
+  document.addEventListener('keydown', e=>{
+    const day = e.target.closest && e.target.closest('[data-slot="calendar"] a[data-day]');
+    if(!day) return;
+    const cal = day.closest('[data-slot="calendar"]');
+    if(e.key==='PageUp' || e.key==='PageDown'){
+      const nav = cal.querySelector('a[data-calendar-nav="'+(e.key==='PageUp'? 'prev':'next')+'"]');
+      if(nav){ e.preventDefault(); nav.click(); }
+      return;
+    }
+    const days = Array.from(cal.querySelectorAll('tbody [data-day]'));
+    const i = days.indexOf(day), weekStart = i - i%7;
+    let from, step;
+    switch(e.key){
+      case 'ArrowLeft': from = i-1; step = -1; break;
+      case 'ArrowRight': from = i+1; step = 1; break;
+      case 'ArrowUp': from = i-7; step = -7; break;
+      case 'ArrowDown': from = i+7; step = 7; break;
+      case 'Home': from = weekStart; step = 1; break;
+      case 'End': from = weekStart+6; step = -1; break;
+      default: return;
+    }
+    e.preventDefault();
+    // disabled days render as spans; skip past them
+    for(let j = from; j>=0 && j<days.length; j += step){
+      if(days[j].tagName==='A'){ days[j].focus(); return; }
+    }
+  });
+})();`
+
+type calendarOpts struct {
+	id           string
+	firstWeekday time.Weekday
+	min, max     time.Time
+	disabled     func(time.Time) bool
+	selected     []time.Time
+	rangeStart   time.Time
+	rangeEnd     time.Time
+	navHref      func(month time.Time) string
+	dayHref      func(day time.Time) string
+	today        time.Time
+	interactive  bool // buttons driven by the date picker asset instead of links
+}
+
+// Internal wrapper so Calendar can pick its own options out of x.DivArg
+type calendarArg struct {
+	x.Global
+	apply func(*calendarOpts)
+}
+
+func newCalendarArg(apply func(*calendarOpts)) x.DivArg {
+	return calendarArg{Global: x.Class(""), apply: apply}
+}
+
+// CalendarID sets the calendar's id, from which its title id is derived. Set it when a page
+// shows more than one calendar for the same month, so each grid's aria-labelledby stays unique.
+func CalendarID(id string) x.DivArg {
+	return newCalendarArg(func(o *calendarOpts) { o.id = id })
+}
+
+// CalendarFirstWeekday sets the first column of the grid (default time.Sunday)
+func CalendarFirstWeekday(d time.Weekday) x.DivArg {
+	return newCalendarArg(func(o *calendarOpts) { o.firstWeekday = d })
+}
+
+// CalendarMin disables every day before t
+func CalendarMin(t time.Time) x.DivArg {
+	return newCalendarArg(func(o *calendarOpts) { o.min = dateOnly(t) })
+}
+
+// CalendarMax disables every day after t
+func CalendarMax(t time.Time) x.DivArg {
+	return newCalendarArg(func(o *calendarOpts) { o.max = dateOnly(t) })
+}
+
+// CalendarDisabled disables every day for which fn returns true (e.g. weekends, holidays).
+// fn runs on the server, so a DatePicker doesn't navigate more than a year from its initial month.
+func CalendarDisabled(fn func(day time.Time) bool) x.DivArg {
+	return newCalendarArg(func(o *calendarOpts) { o.disabled = fn })
+}
+
+// CalendarSelected marks one (single selection) or more (multiple selection) days as selected
+func CalendarSelected(days ...time.Time) x.DivArg {
+	return newCalendarArg(func(o *calendarOpts) { o.selected = append(o.selected, days...) })
+}
+
+// CalendarRange marks start..end as a selected range; a zero end selects only start
+func CalendarRange(start, end time.Time) x.DivArg {
+	return newCalendarArg(func(o *calendarOpts) {
+		o.rangeStart, o.rangeEnd = dateOnly(start), dateOnly(end)
+		if !o.rangeEnd.IsZero() && o.rangeEnd.Before(o.rangeStart) {
+			o.rangeStart, o.rangeEnd = o.rangeEnd, o.rangeStart
+		}
+	})
+}
+
+// CalendarNavHref enables previous/next month links; fn returns the URL showing month
+func CalendarNavHref(fn func(month time.Time) string) x.DivArg {
+	return newCalendarArg(func(o *calendarOpts) { o.navHref = fn })
+}
+
+// CalendarDayHref renders each enabled day as a link; fn returns the URL selecting day
+func CalendarDayHref(fn func(day time.Time) string) x.DivArg {
+	return newCalendarArg(func(o *calendarOpts) { o.dayHref = fn })
+}
+
+// CalendarToday overrides the day highlighted as today (default time.Now in month's location)
+func CalendarToday(t time.Time) x.DivArg {
+	return newCalendarArg(func(o *calendarOpts) { o.today = dateOnly(t) })
+}
+
+// calendarButtons renders days and navigation as buttons for the date picker asset, and exposes
+// the bounds it needs to re-render other months client-side
+func calendarButtons() x.DivArg {
+	return newCalendarArg(func(o *calendarOpts) { o.interactive = true })
+}
+
+// CalendarWeeks returns the days shown for month, one slice of 7 per week starting on first.
+// Leading and trailing days from the adjacent months fill the first and last week.
+func CalendarWeeks(month time.Time, first time.Weekday) [][]time.Time {
+	start := time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, month.Location())
+	offset := (int(start.Weekday()) - int(first) + 7) % 7
+	day := start.AddDate(0, 0, -offset)
+
+	var weeks [][]time.Time
+	for len(weeks) == 0 || day.Month() == start.Month() {
+		week := make([]time.Time, 7)
+		for i := range week {
+			week[i] = day
+			day = day.AddDate(0, 0, 1)
+		}
+		weeks = append(weeks, week)
+	}
+	return weeks
+}
+
+// Calendar renders the month containing month as an ARIA grid, entirely in Go.
+// Configure it with the Calendar* options; selection is expressed through links
+// (CalendarDayHref, CalendarNavHref) so it works without JS. The grid is one tab stop;
+// arrow keys, Home/End and PageUp/PageDown move between days and months.
+func Calendar(month time.Time, args ...x.DivArg) x.Node {
+	o := calendarOpts{firstWeekday: time.Sunday}
+	var divArgs []x.DivArg
+	for _, a := range args {
+		if c, ok := a.(calendarArg); ok {
+			c.apply(&o)
+			continue
+		}
+		divArgs = append(divArgs, a)
+	}
+	month = time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, month.Location())
+	if o.today.IsZero() {
+		o.today = dateOnly(time.Now().In(month.Location()))
+	}
+
+	titleID := "calendar-" + month.Format("2006-01") + "-title"
+	if o.id != "" {
+		titleID = o.id + "-title"
+	}
+	header := x.Div(
+		x.Class("flex items-center justify-between gap-2 pb-3"),
+		o.navLink(month.AddDate(0, -1, 0), "prev", lucide.ChevronLeft()),
+		x.Div(
+			x.Class("text-sm font-medium"),
+			x.Id(titleID),
+			x.Data("slot", "calendar-title"),
+			x.Aria("live", "polite"),
+			x.T(month.Format("January 2006")),
+		),
+		o.navLink(month.AddDate(0, 1, 0), "next", lucide.ChevronRight()),
+	)
+
+	headRow := []x.TrArg{x.Class("flex")}
+	for i := 0; i < 7; i++ {
+		wd := time.Weekday((int(o.firstWeekday) + i) % 7)
+		headRow = append(headRow, x.Th(
+			x.Class("w-8 text-[0.8rem] font-normal text-muted-foreground"),
+			x.Scope("col"),
+			x.Aria("label", wd.String()),
+			x.T(wd.String()[:2]),
+		))
+	}
+
+	focus := o.focusDay(month)
+	bodyArgs := []x.TbodyArg{}
+	for _, week := range CalendarWeeks(month, o.firstWeekday) {
+		rowArgs := []x.TrArg{x.Class("mt-2 flex w-full")}
+		for _, d := range week {
+			rowArgs = append(rowArgs, o.dayCell(d, month, sameDay(d, focus)))
+		}
+		bodyArgs = append(bodyArgs, x.Tr(rowArgs...))
+	}
+
+	multi := len(o.selected) > 1 || !o.rangeStart.IsZero()
+	gridArgs := []x.TableArg{
+		x.Class("w-full border-collapse"),
+		x.Role("grid"),
+		x.Aria("labelledby", titleID),
+		x.Thead(x.Tr(headRow...)),
+		x.Tbody(bodyArgs...),
+	}
+	if multi {
+		gridArgs = append(gridArgs, x.Aria("multiselectable", "true"))
+	}
+
+	rootArgs := []x.DivArg{
+		x.Class("w-fit rounded-md bg-background p-3"),
+		x.Data("slot", "calendar"),
+		header,
+		x.Table(gridArgs...),
+	}
+	if o.id != "" {
+		rootArgs = append(rootArgs, x.Id(o.id))
+	}
+	if o.interactive {
+		rootArgs = append(rootArgs, o.clientData(month)...)
+	}
+	rootArgs = append(rootArgs, divArgs...)
+
+	return x.Div(rootArgs...).WithAssets("", calendarJS, "calendar")
+}
+
+// focusDay picks the day that takes the grid's tab stop: the selection, then today,
+// then the first enabled day of month
+func (o calendarOpts) focusDay(month time.Time) time.Time {
+	inMonth := func(d time.Time) bool {
+		return !d.IsZero() && d.Year() == month.Year() && d.Month() == month.Month() && !o.isDisabled(dateOnly(d))
+	}
+	for _, d := range append([]time.Time{o.rangeStart}, o.selected...) {
+		if inMonth(d) {
+			return d
+		}
+	}
+	if inMonth(o.today) {
+		return o.today
+	}
+	for d := month; d.Month() == month.Month(); d = d.AddDate(0, 0, 1) {
+		if !o.isDisabled(d) {
+			return d
+		}
+	}
+	return time.Time{}
+}
+
+// navLink renders a previous/next ("prev"/"next") month link, or an inert placeholder when
+// navigation is off or out of bounds
+func (o calendarOpts) navLink(month time.Time, dir string, icon x.Node) x.Node {
+	label := "Next month"
+	if dir == "prev" {
+		label = "Previous month"
+	}
+	cls := ButtonClass(ButtonOutline(), ButtonIcon())
+	lastDay := month.AddDate(0, 1, -1)
+	outOfBounds := (!o.min.IsZero() && lastDay.Before(o.min)) || (!o.max.IsZero() && month.After(o.max))
+	if o.interactive {
+		btnArgs := []x.ButtonArg{cls, x.Class("size-7"), x.ButtonType("button"), x.Data("calendar-nav", dir), x.Aria("label", label), icon}
+		if outOfBounds {
+			btnArgs = append(btnArgs, x.Disabled())
+		}
+		return x.Button(btnArgs...)
+	}
+	if o.navHref == nil || outOfBounds {
+		return x.Span(cls, x.Class("size-7 pointer-events-none opacity-50"), x.Aria("disabled", "true"), x.Aria("label", label), icon)
+	}
+	return x.A(cls, x.Class("size-7"), x.Href(o.navHref(month)), x.Data("calendar-nav", dir), x.Aria("label", label), icon)
+}
+
+func (o calendarOpts) isDisabled(d time.Time) bool {
+	if !o.min.IsZero() && d.Before(o.min) {
+		return true
+	}
+	if !o.max.IsZero() && d.After(o.max) {
+		return true
+	}
+	return o.disabled != nil && o.disabled(d)
+}
+
+func (o calendarOpts) isSelected(d time.Time) bool {
+	for _, s := range o.selected {
+		if sameDay(s, d) {
+			return true
+		}
+	}
+	return false
+}
+
+func (o calendarOpts) dayCell(d, month time.Time, focus bool) x.Node {
+	dayCls := "inline-flex size-8 items-center justify-center rounded-md text-sm font-normal tabular-nums transition-colors hover:bg-accent hover:text-accent-foreground focus-visible:outline-none focus-visible:ring-[3px] focus-visible:ring-ring/50 data-[outside=true]:text-muted-foreground data-[today=true]:bg-accent data-[today=true]:text-accent-foreground data-[range-middle=true]:rounded-none data-[range-middle=true]:bg-accent data-[range-middle=true]:text-accent-foreground data-[selected=true]:bg-primary data-[selected=true]:text-primary-foreground data-[disabled=true]:pointer-events-none data-[disabled=true]:opacity-50"
+
+	disabled := o.isDisabled(d)
+	selected := o.isSelected(d)
+	var rangeMiddle bool
+	if !o.rangeStart.IsZero() {
+		switch {
+		case sameDay(d, o.rangeStart) || (!o.rangeEnd.IsZero() && sameDay(d, o.rangeEnd)):
+			selected = true
+		case !o.rangeEnd.IsZero() && d.After(o.rangeStart) && d.Before(o.rangeEnd):
+			rangeMiddle = true
+		}
+	}
+
+	attrs := []x.Global{
+		x.Class(dayCls),
+		x.Data("day", d.Format("2006-01-02")),
+		x.Aria("label", d.Format("Monday, January 2, 2006")),
+	}
+	if d.Month() != month.Month() {
+		attrs = append(attrs, x.Data("outside", "true"))
+	}
+	if sameDay(d, o.today) {
+		attrs = append(attrs, x.Data("today", "true"), x.Aria("current", "date"))
+	}
+	if selected {
+		attrs = append(attrs, x.Data("selected", "true"))
+	}
+	if rangeMiddle {
+		attrs = append(attrs, x.Data("range-middle", "true"))
+	}
+	if disabled {
+		attrs = append(attrs, x.Data("disabled", "true"))
+	}
+
+	tabIndex := -1
+	if focus {
+		tabIndex = 0
+	}
+
+	label := x.T(strconv.Itoa(d.Day()))
+	var content x.Node
+	if o.interactive {
+		btnArgs := []x.ButtonArg{x.ButtonType("button"), x.TabIndex(tabIndex), label}
+		for _, g := range attrs {
+			btnArgs = append(btnArgs, g)
+		}
+		if disabled {
+			btnArgs = append(btnArgs, x.Disabled())
+		}
+		content = x.Button(btnArgs...)
+	} else if o.dayHref != nil && !disabled {
+		aArgs := []x.AArg{x.Href(o.dayHref(d)), x.TabIndex(tabIndex), label}
+		for _, g := range attrs {
+			aArgs = append(aArgs, g)
+		}
+		content = x.A(aArgs...)
+	} else {
+		spanArgs := []x.SpanArg{label}
+		for _, g := range attrs {
+			spanArgs = append(spanArgs, g)
+		}
+		content = x.Span(spanArgs...)
+	}
+
+	tdArgs := []x.TdArg{x.Class("relative p-0 text-center"), x.Role("gridcell"), content}
+	if selected || rangeMiddle {
+		tdArgs = append(tdArgs, x.Aria("selected", "true"))
+	}
+	if disabled {
+		tdArgs = append(tdArgs, x.Aria("disabled", "true"))
+	}
+	return x.Td(tdArgs...)
+}
+
+// clientData exposes month, today, bounds and predicate-disabled days so the date picker asset
+// can render other months with the same rules. The predicate can only be evaluated here, for a
+// year either side of month, so the bounds are narrowed to that window to keep the client in it.
+func (o calendarOpts) clientData(month time.Time) []x.DivArg {
+	data := []x.DivArg{
+		x.Data("month", month.Format("2006-01")),
+		x.Data("today", o.today.Format("2006-01-02")),
+		x.Data("first-weekday", strconv.Itoa(int(o.firstWeekday))),
+	}
+	min, max := o.min, o.max
+	if o.disabled != nil {
+		from, to := month.AddDate(-1, 0, 0), month.AddDate(1, 1, -1)
+		if min.IsZero() || min.Before(from) {
+			min = from
+		}
+		if max.IsZero() || max.After(to) {
+			max = to
+		}
+		var days []string
+		for d := min; !d.After(max); d = d.AddDate(0, 0, 1) {
+			if o.disabled(d) {
+				days = append(days, d.Format("2006-01-02"))
+			}
+		}
+		data = append(data, x.Data("disabled-dates", strings.Join(days, ",")))
+	}
+	if !min.IsZero() {
+		data = append(data, x.Data("min", min.Format("2006-01-02")))
+	}
+	if !max.IsZero() {
+		data = append(data, x.Data("max", max.Format("2006-01-02")))
+	}
+	return data
+}
+
+func dateOnly(t time.Time) time.Time {
+	if t.IsZero() {
+		return t
+	}
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+func sameDay(a, b time.Time) bool {
+	return a.Year() == b.Year() && a.Month() == b.Month() && a.Day() == b.Day()
+}
@@ -0,0 +1,154 @@
+package ui
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	x "github.com/plainkit/html"
+)
+
+func calDay(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// dayTag returns the start tag of the element rendering d; attribute order isn't stable
+func dayTag(t *testing.T, html string, d time.Time) string {
+	t.Helper()
+	tag := regexp.MustCompile(`<[a-z]+ [^>]*data-day="` + d.Format("2006-01-02") + `"[^>]*>`).FindString(html)
+	if tag == "" {
+		t.Fatalf("day %s not rendered", d.Format("2006-01-02"))
+	}
+	return tag
+}
+
+func TestCalendarWeeks(t *testing.T) {
+	tests := []struct {
+		month      time.Time
+		first      time.Weekday
+		start, end time.Time
+		weeks      int
+	}{
+		{calDay(2026, time.October, 1), time.Sunday, calDay(2026, time.September, 27), calDay(2026, time.October, 31), 5},
+		{calDay(2026, time.October, 1), time.Monday, calDay(2026, time.September, 28), calDay(2026, time.November, 1), 5},
+		{calDay(2015, time.February, 1), time.Sunday, calDay(2015, time.February, 1), calDay(2015, time.February, 28), 4},
+		{calDay(2026, time.August, 1), time.Sunday, calDay(2026, time.July, 26), calDay(2026, time.September, 5), 6},
+	}
+	for _, tt := range tests {
+		weeks := CalendarWeeks(tt.month, tt.first)
+		last := weeks[len(weeks)-1]
+		if len(weeks) != tt.weeks || !weeks[0][0].Equal(tt.start) || !last[6].Equal(tt.end) {
+			t.Errorf("CalendarWeeks(%s, %s) = %d weeks %s..%s, want %d weeks %s..%s",
+				tt.month.Format("2006-01"), tt.first, len(weeks), weeks[0][0].Format("01-02"), last[6].Format("01-02"),
+				tt.weeks, tt.start.Format("01-02"), tt.end.Format("01-02"))
+		}
+		for _, w := range weeks {
+			if w[0].Weekday() != tt.first {
+				t.Errorf("week starting %s doesn't start on %s", w[0].Format("2006-01-02"), tt.first)
+			}
+		}
+	}
+}
+
+func TestCalendarMinMax(t *testing.T) {
+	href := func(d time.Time) string { return "?d=" + d.Format("2006-01-02") }
+	html := x.Render(Calendar(calDay(2026, time.October, 1),
+		CalendarMin(calDay(2026, time.October, 10).Add(15*time.Hour)),
+		CalendarMax(calDay(2026, time.October, 20)),
+		CalendarDayHref(href),
+		CalendarNavHref(func(time.Time) string { return "?m" }),
+	))
+
+	for _, d := range []time.Time{calDay(2026, time.October, 9), calDay(2026, time.October, 21)} {
+		if tag := dayTag(t, html, d); !strings.HasPrefix(tag, "<span") || !strings.Contains(tag, `data-disabled="true"`) {
+			t.Errorf("out of bounds day rendered as %s", tag)
+		}
+	}
+	// bounds are inclusive, whatever the time of day
+	for _, d := range []time.Time{calDay(2026, time.October, 10), calDay(2026, time.October, 20)} {
+		if tag := dayTag(t, html, d); !strings.HasPrefix(tag, "<a") || strings.Contains(tag, "data-disabled") {
+			t.Errorf("in bounds day rendered as %s", tag)
+		}
+	}
+	if strings.Contains(html, `data-calendar-nav="prev"`) || strings.Contains(html, `data-calendar-nav="next"`) {
+		t.Error("navigation past min/max should be inert")
+	}
+}
+
+func TestCalendarRange(t *testing.T) {
+	// reversed bounds are swapped
+	html := x.Render(Calendar(calDay(2026, time.October, 1),
+		CalendarRange(calDay(2026, time.October, 14), calDay(2026, time.October, 12)),
+	))
+
+	for _, d := range []time.Time{calDay(2026, time.October, 12), calDay(2026, time.October, 14)} {
+		if tag := dayTag(t, html, d); !strings.Contains(tag, `data-selected="true"`) {
+			t.Errorf("range end not selected: %s", tag)
+		}
+	}
+	if tag := dayTag(t, html, calDay(2026, time.October, 13)); !strings.Contains(tag, `data-range-middle="true"`) {
+		t.Errorf("range middle not marked: %s", tag)
+	}
+	for _, d := range []time.Time{calDay(2026, time.October, 11), calDay(2026, time.October, 15)} {
+		if tag := dayTag(t, html, d); strings.Contains(tag, "data-selected") || strings.Contains(tag, "data-range-middle") {
+			t.Errorf("day outside range marked: %s", tag)
+		}
+	}
+	if !strings.Contains(html, `aria-multiselectable="true"`) {
+		t.Error("range calendar should be multiselectable")
+	}
+}
+
+func TestCalendarSingleTabStop(t *testing.T) {
+	href := func(d time.Time) string { return "?d=" + d.Format("2006-01-02") }
+	html := x.Render(Calendar(calDay(2026, time.October, 1),
+		CalendarToday(calDay(2026, time.October, 19)),
+		CalendarSelected(calDay(2026, time.October, 5)),
+		CalendarDayHref(href),
+	))
+
+	if n := strings.Count(html, `tabindex="0"`); n != 1 {
+		t.Fatalf("grid has %d tab stops, want 1", n)
+	}
+	if tag := dayTag(t, html, calDay(2026, time.October, 5)); !strings.Contains(tag, `tabindex="0"`) {
+		t.Errorf("selected day should take the tab stop: %s", tag)
+	}
+}
+
+func TestCalendarIDsAreUnique(t *testing.T) {
+	from := x.Render(DatePicker("from"))
+	to := x.Render(DatePicker("to"))
+	titleID := regexp.MustCompile(`id="([^"]*-title)"`)
+
+	a, b := titleID.FindStringSubmatch(from), titleID.FindStringSubmatch(to)
+	if a == nil || b == nil || a[1] == b[1] {
+		t.Fatalf("date pickers share calendar title id: %v %v", a, b)
+	}
+	if !strings.Contains(from, `aria-labelledby="`+a[1]+`"`) {
+		t.Errorf("grid not labelled by its own title %q", a[1])
+	}
+}
+
+func TestDatePickerClientWindow(t *testing.T) {
+	weekend := func(d time.Time) bool { return d.Weekday() == time.Saturday || d.Weekday() == time.Sunday }
+	html := x.Render(DatePicker("due",
+		CalendarToday(calDay(2026, time.October, 19)),
+		CalendarDisabled(weekend),
+	))
+
+	for _, want := range []string{`data-month="2026-10"`, `data-today="2026-10-19"`, `data-min="2025-10-01"`, `data-max="2027-10-31"`} {
+		if !strings.Contains(html, want) {
+			t.Errorf("missing %s", want)
+		}
+	}
+	// the predicate's window doesn't widen explicit bounds
+	html = x.Render(DatePicker("due",
+		CalendarToday(calDay(2026, time.October, 19)),
+		CalendarDisabled(weekend),
+		CalendarMax(calDay(2026, time.December, 31)),
+	))
+	if !strings.Contains(html, `data-max="2026-12-31"`) || !strings.Contains(html, "2026-12-27") || strings.Contains(html, "2027-01-02") {
+		t.Error("disabled dates not limited to the explicit max")
+	}
+}
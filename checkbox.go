@@ -5,18 +5,119 @@ import (
 	"github.com/plainkit/icons/lucide"
 )
 
+const checkboxJS = `(function(){
+  function children(group){
+    return Array.from(document.querySelectorAll('input[data-checkbox-child="'+CSS.escape(group)+'"]'));
+  }
+
+  function setMixed(input, mixed){
+    input.indeterminate = mixed;
+    if(mixed){ input.setAttribute('aria-checked','mixed'); input.dataset.indeterminate = 'true'; }
+    else { input.removeAttribute('aria-checked'); delete input.dataset.indeterminate; }
+  }
+
+  // parent reflects its children: all -> checked, some -> mixed, none -> unchecked
+  function syncParent(group){
+    const parent = document.querySelector('input[data-checkbox-parent="'+CSS.escape(group)+'"]');
+    if(!parent) return;
+    const kids = children(group).filter(c=>!c.disabled);
+    const n = kids.filter(c=>c.checked).length;
+    parent.checked = kids.length>0 && n===kids.length;
+    setMixed(parent, n>0 && n<kids.length);
+    if(parent.dataset.checkboxChild) syncParent(parent.dataset.checkboxChild);
+  }
+
+  function init(){
+    document.querySelectorAll('input[data-indeterminate="true"]').forEach(i=>setMixed(i, true));
+    document.querySelectorAll('input[data-checkbox-parent]').forEach(p=>syncParent(p.dataset.checkboxParent));
+  }
+
+  // readonly checkboxes keep their value: the mouse is stopped in CSS, Space lands here as a click
+  document.addEventListener('click', e=>{
+    if(e.target.matches && e.target.matches('input[type="checkbox"][aria-readonly="true"]')) e.preventDefault();
+  }, true);
+
+  document.addEventListener('change', e=>{
+    const t = e.target;
+    if(!(t instanceof HTMLInputElement) || t.type!=='checkbox') return;
+    setMixed(t, false);
+    if(t.dataset.checkboxParent){
+      children(t.dataset.checkboxParent).forEach(c=>{ if(!c.disabled){ c.checked = t.checked; setMixed(c, false); } });
+    }
+    if(t.dataset.checkboxChild) syncParent(t.dataset.checkboxChild);
+  });
+
+  if(document.readyState==='loading'){
+    document.addEventListener('DOMContentLoaded', init);
+  }else{
+    init();
+  }
+})();`
+
+// Internal wrappers so Checkbox can tell when the sync asset is needed
+type checkboxIndeterminateArg struct{ x.Global }
+
+type checkboxGroupArg struct{ x.Global }
+
+// CheckboxIndeterminate renders the checkbox in the mixed state (minus icon, aria-checked="mixed").
+// The state clears as soon as the user toggles it.
+func CheckboxIndeterminate() x.InputArg {
+	return checkboxIndeterminateArg{Global: x.Data("indeterminate", "true")}
+}
+
+// CheckboxParent marks a "select all" checkbox that controls every CheckboxChild of the same group
+func CheckboxParent(group string) x.InputArg {
+	return checkboxGroupArg{Global: x.Data("checkbox-parent", group)}
+}
+
+// CheckboxChild marks a checkbox as a member of group; its parent becomes mixed when only some are checked
+func CheckboxChild(group string) x.InputArg {
+	return checkboxGroupArg{Global: x.Data("checkbox-child", group)}
+}
+
+// CheckboxChildState describes one CheckboxChild for CheckboxParentState
+type CheckboxChildState struct {
+	Checked, Disabled bool
+}
+
+// CheckboxParentState returns the initial state for a parent checkbox from its children, so
+// server rendering matches what the sync asset computes: disabled children don't count.
+func CheckboxParentState(children ...CheckboxChildState) x.InputArg {
+	var checked, total int
+	for _, c := range children {
+		if c.Disabled {
+			continue
+		}
+		total++
+		if c.Checked {
+			checked++
+		}
+	}
+	switch {
+	case total > 0 && checked == total:
+		return x.Checked()
+	case checked > 0:
+		return CheckboxIndeterminate()
+	}
+	return x.Class("")
+}
+
 // Checkbox renders an accessible, functional checkbox using a hidden native input
 // and a styled indicator controlled purely via CSS sibling selectors.
 // Pass input attributes via x.InputArg (Id, Name, Required, etc.).
+// Indeterminate and parent/child groups opt into a small asset that keeps them in sync.
+// x.Readonly() keeps the value posted but prevents toggling, as on Switch.
 func Checkbox(args ...x.InputArg) x.Node {
 	// Container label to make the whole control clickable and tie to input
-	container := "flex items-center gap-2 cursor-pointer text-sm select-none relative"
+	container := "flex items-center gap-2 cursor-pointer text-sm select-none relative has-[[aria-readonly=true]]:pointer-events-none"
 	// Hidden native input to drive state and accessibility
 	inputCls := "absolute left-0 top-0 size-4 opacity-0 cursor-pointer"
 	// Visual indicator box; state driven by sibling selectors
 	indicator := "indicator size-4 shrink-0 rounded-[4px] border border-input bg-background dark:bg-input/30 shadow-xs transition-colors flex items-center justify-center text-transparent"
 	// State styles: hover, checked, focus-visible
 	states := " hover:[&>.indicator]:bg-muted [&>input:checked~.indicator]:bg-primary [&>input:checked~.indicator]:border-primary [&>input:checked~.indicator]:text-primary-foreground [&>input:focus-visible~.indicator]:ring-[3px] [&>input:focus-visible~.indicator]:ring-ring/50"
+	// Indeterminate styles: swap the check for the minus icon
+	mixed := " [&>input[data-indeterminate=true]~.indicator]:bg-primary [&>input[data-indeterminate=true]~.indicator]:border-primary [&>input[data-indeterminate=true]~.indicator]:text-primary-foreground [&>input[data-indeterminate=true]~.indicator>.lucide-check]:hidden [&>input[data-indeterminate=true]~.indicator>.lucide-minus]:block"
 
 	// Build input args
 	inputArgs := append([]x.InputArg{
@@ -24,9 +125,32 @@ func Checkbox(args ...x.InputArg) x.Node {
 		x.InputType("checkbox"),
 	}, args...)
 
-	return x.FormLabel(
-		x.Class(container+states),
+	var needsJS bool
+	for _, a := range args {
+		switch a.(type) {
+		case checkboxIndeterminateArg:
+			needsJS = true
+			inputArgs = append(inputArgs, x.Aria("checked", "mixed"))
+		case checkboxGroupArg:
+			needsJS = true
+		case x.ReadonlyOpt:
+			// Checkboxes ignore readonly natively; the asset blocks toggling by keyboard
+			needsJS = true
+			inputArgs = append(inputArgs, x.Aria("readonly", "true"))
+		}
+	}
+
+	node := x.FormLabel(
+		x.Class(container+states+mixed),
 		x.Input(inputArgs...),
-		x.Span(x.Class(indicator), lucide.Check(lucide.Size("14"))),
+		x.Span(
+			x.Class(indicator),
+			lucide.Check(lucide.Size("14"), x.Class("lucide lucide-check")),
+			lucide.Minus(lucide.Size("14"), x.Class("lucide lucide-minus hidden")),
+		),
 	)
+	if needsJS {
+		return node.WithAssets("", checkboxJS, "checkbox")
+	}
+	return node
 }
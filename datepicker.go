@@ -0,0 +1,384 @@
+package ui
+
+import (
+	"strconv"
+	"time"
+
+	x "github.com/plainkit/html"
+	"github.com/plainkit/icons/lucide"
+)
+
+const datePickerJS = `(function(){
+  const pad = n=>String(n).padStart(2,'0');
+  const iso = d=>d.getFullYear()+'-'+pad(d.getMonth()+1)+'-'+pad(d.getDate());
+  const parse = s=>{ if(!s) return null; const p = s.split('-').map(Number); return new Date(p[0], p[1]-1, p[2]||1); };
+  const labelFmt = new Intl.DateTimeFormat('en-US', {month:'short', day:'numeric', year:'numeric'});
+  const longFmt = new Intl.DateTimeFormat('en-US', {weekday:'long', month:'long', day:'numeric', year:'numeric'});
+  const monthFmt = new Intl.DateTimeFormat('en-US', {month:'long', year:'numeric'});
+  function flag(el, name, on){ if(on) el.dataset[name] = 'true'; else delete el.dataset[name]; }
+
+  function init(picker){
+    const cal = picker.querySelector('[data-slot="calendar"]');
+    const content = picker.querySelector('[data-slot="date-picker-content"]');
+    const trigger = picker.querySelector('[data-slot="date-picker-trigger"]');
+    const label = picker.querySelector('[data-slot="date-picker-label"]');
+    const inputs = picker.querySelectorAll('input[type="hidden"]');
+    if(!cal || !content || !inputs.length) return;
+    const range = picker.dataset.mode==='range';
+    const tbody = cal.querySelector('tbody');
+    const rowTpl = tbody.querySelector('tr').cloneNode(true);
+    const disabledDates = new Set((cal.dataset.disabledDates||'').split(',').filter(Boolean));
+    const min = cal.dataset.min||'', max = cal.dataset.max||'';
+    const first = parseInt(cal.dataset.firstWeekday||'0', 10);
+    let view = parse(cal.dataset.month);
+    let start = inputs[0].value, end = range ? inputs[1].value : '';
+
+    // ISO dates compare correctly as strings
+    const isDisabled = s=>(min && s<min) || (max && s>max) || disabledDates.has(s);
+    const close = ()=>{ if(content.hidePopover) content.hidePopover(); trigger.focus(); };
+
+    function decorate(){
+      cal.querySelectorAll('[data-day]').forEach(btn=>{
+        const s = btn.dataset.day;
+        const sel = s===start || (range && s===end);
+        const mid = range && start && end && s>start && s<end;
+        flag(btn, 'selected', sel);
+        flag(btn, 'rangeMiddle', mid);
+        if(sel||mid) btn.parentElement.setAttribute('aria-selected','true');
+        else btn.parentElement.removeAttribute('aria-selected');
+      });
+    }
+
+    // re-render the grid for view, reusing a server-rendered row as the template
+    function render(){
+      const y = view.getFullYear(), m = view.getMonth(), today = cal.dataset.today || iso(new Date());
+      cal.querySelector('[data-slot="calendar-title"]').textContent = monthFmt.format(view);
+      let d = new Date(y, m, 1-((new Date(y, m, 1).getDay()-first+7)%7));
+      const rows = [];
+      do {
+        const tr = rowTpl.cloneNode(true);
+        tr.querySelectorAll('td').forEach(td=>{
+          const btn = td.querySelector('[data-day]'), s = iso(d), off = isDisabled(s);
+          btn.dataset.day = s;
+          btn.textContent = d.getDate();
+          btn.setAttribute('aria-label', longFmt.format(d));
+          btn.disabled = off;
+          flag(btn, 'disabled', off);
+          flag(btn, 'outside', d.getMonth()!==m);
+          flag(btn, 'today', s===today);
+          if(s===today) btn.setAttribute('aria-current','date'); else btn.removeAttribute('aria-current');
+          if(off) td.setAttribute('aria-disabled','true'); else td.removeAttribute('aria-disabled');
+          d = new Date(d.getFullYear(), d.getMonth(), d.getDate()+1);
+        });
+        rows.push(tr);
+      } while(d.getMonth()===m);
+      tbody.replaceChildren(...rows);
+      cal.querySelector('[data-calendar-nav="prev"]').disabled = !!min && iso(new Date(y, m, 0))<min;
+      cal.querySelector('[data-calendar-nav="next"]').disabled = !!max && iso(new Date(y, m+1, 1))>max;
+      decorate();
+      rove();
+    }
+
+    // keep the grid a single tab stop after re-rendering: selection, today, or first enabled day
+    function rove(){
+      const days = Array.from(cal.querySelectorAll('[data-day]'));
+      days.forEach(b=>{ b.tabIndex = -1; });
+      const focus = cal.querySelector('[data-selected="true"]:not([data-outside])') || cal.querySelector('[data-today="true"]:not([data-outside]):not([disabled])') || days.find(b=>!b.disabled && !b.dataset.outside);
+      if(focus) focus.tabIndex = 0;
+    }
+
+    function commit(){
+      let text = start ? labelFmt.format(parse(start)) : '';
+      if(range && end) text += ' – '+labelFmt.format(parse(end));
+      label.textContent = text || picker.dataset.placeholder || '';
+      flag(trigger, 'empty', !start);
+      inputs[0].value = start;
+      if(range) inputs[1].value = end;
+      inputs.forEach(i=>i.dispatchEvent(new Event('change', {bubbles:true})));
+      decorate();
+    }
+
+    function pick(s){
+      if(!range){ start = s; commit(); close(); return; }
+      if(!start || end){ start = s; end = ''; }
+      else if(s<start){ end = start; start = s; }
+      else { end = s; }
+      commit();
+      if(end) close();
+    }
+
+    cal.addEventListener('click', e=>{
+      const nav = e.target.closest('[data-calendar-nav]');
+      if(nav){
+        view = new Date(view.getFullYear(), view.getMonth()+(nav.dataset.calendarNav==='prev'? -1 : 1), 1);
+        render();
+        return;
+      }
+      const btn = e.target.closest('[data-day]');
+      if(btn && !btn.disabled) pick(btn.dataset.day);
+    });
+
+    // arrow keys move by day/week, crossing into adjacent months
+    cal.addEventListener('keydown', e=>{
+      const btn = e.target.closest('[data-day]');
+      const delta = {ArrowLeft:-1, ArrowRight:1, ArrowUp:-7, ArrowDown:7}[e.key];
+      if(!btn || !delta) return;
+      e.preventDefault();
+      const d = parse(btn.dataset.day), next = new Date(d.getFullYear(), d.getMonth(), d.getDate()+delta), s = iso(next);
+      let target = cal.querySelector('[data-day="'+s+'"]');
+      if(!target || target.dataset.outside){
+        view = new Date(next.getFullYear(), next.getMonth(), 1);
+        render();
+        target = cal.querySelector('[data-day="'+s+'"]');
+      }
+      if(target) target.focus();
+    });
+
+    picker.querySelectorAll('[data-preset-start]').forEach(btn=>{
+      btn.addEventListener('click', ()=>{
+        start = btn.dataset.presetStart;
+        end = btn.dataset.presetEnd;
+        view = parse(start.slice(0, 7));
+        render();
+        commit();
+        close();
+      });
+    });
+
+    // anchor the popover below the trigger and focus the selected day
+    content.addEventListener('toggle', e=>{
+      if(e.newState!=='open') return;
+      const r = trigger.getBoundingClientRect(), w = content.offsetWidth, h = content.offsetHeight;
+      let top = r.bottom+4;
+      if(top+h>innerHeight) top = Math.max(8, r.top-4-h);
+      content.style.top = top+'px';
+      content.style.left = Math.max(8, Math.min(r.left, innerWidth-w-8))+'px';
+      const focus = cal.querySelector('[data-selected="true"]:not([data-outside])') || cal.querySelector('[data-today="true"]') || cal.querySelector('[data-day]:not([disabled])');
+      if(focus) focus.focus();
+    });
+  }
+
+  if(document.readyState==='loading'){
+    document.addEventListener('DOMContentLoaded',()=>{
+      document.querySelectorAll('[data-slot="date-picker"]').forEach(init);
+    });
+  }else{
+    document.querySelectorAll('[data-slot="date-picker"]').forEach(init);
+  }
+})();`
+
+// DateRangePreset is a named shortcut shown next to the DateRangePicker calendar
+type DateRangePreset struct {
+	Label string
+	Start time.Time
+	End   time.Time
+}
+
+// DateRangePresetLastDays covers the n days ending today, e.g. "Last 7 days"
+func DateRangePresetLastDays(n int, today time.Time) DateRangePreset {
+	return DateRangePreset{
+		Label: "Last " + strconv.Itoa(n) + " days",
+		Start: dateOnly(today).AddDate(0, 0, 1-n),
+		End:   dateOnly(today),
+	}
+}
+
+// DateRangePresetThisMonth covers the calendar month containing today
+func DateRangePresetThisMonth(today time.Time) DateRangePreset {
+	first := time.Date(today.Year(), today.Month(), 1, 0, 0, 0, 0, today.Location())
+	return DateRangePreset{Label: "This month", Start: first, End: first.AddDate(0, 1, -1)}
+}
+
+// DateRangePresetLastMonth covers the calendar month before the one containing today
+func DateRangePresetLastMonth(today time.Time) DateRangePreset {
+	first := time.Date(today.Year(), today.Month(), 1, 0, 0, 0, 0, today.Location()).AddDate(0, -1, 0)
+	return DateRangePreset{Label: "Last month", Start: first, End: first.AddDate(0, 1, -1)}
+}
+
+type datePickerOpts struct {
+	start, end   time.Time
+	placeholder  string
+	presets      []DateRangePreset
+	calendarArgs []x.DivArg
+}
+
+// Internal wrapper so DatePicker can pick its own options out of x.DivArg
+type datePickerArg struct {
+	x.Global
+	apply func(*datePickerOpts)
+}
+
+func newDatePickerArg(apply func(*datePickerOpts)) x.DivArg {
+	return datePickerArg{Global: x.Class(""), apply: apply}
+}
+
+// DatePickerValue sets the initially selected date
+func DatePickerValue(t time.Time) x.DivArg {
+	return newDatePickerArg(func(o *datePickerOpts) { o.start = dateOnly(t) })
+}
+
+// DatePickerPlaceholder sets the trigger text shown while no date is selected
+func DatePickerPlaceholder(s string) x.DivArg {
+	return newDatePickerArg(func(o *datePickerOpts) { o.placeholder = s })
+}
+
+// DateRangePickerValue sets the initially selected range
+func DateRangePickerValue(start, end time.Time) x.DivArg {
+	return newDatePickerArg(func(o *datePickerOpts) { o.start, o.end = dateOnly(start), dateOnly(end) })
+}
+
+// DateRangePickerPresets lists shortcuts such as DateRangePresetLastDays(7, today)
+func DateRangePickerPresets(presets ...DateRangePreset) x.DivArg {
+	return newDatePickerArg(func(o *datePickerOpts) { o.presets = append(o.presets, presets...) })
+}
+
+// DatePicker renders a trigger styled like ButtonOutline that opens a Calendar popover and
+// posts the selected day as an ISO date (2006-01-02) under name. Calendar options
+// (CalendarMin, CalendarMax, CalendarDisabled, CalendarFirstWeekday, CalendarToday) are forwarded
+// to the calendar; without a value it opens on the month of CalendarToday.
+func DatePicker(name string, args ...x.DivArg) x.Node {
+	o, rootArgs := datePickerOptions(args)
+	if o.placeholder == "" {
+		o.placeholder = "Pick a date"
+	}
+
+	calArgs := o.calendarArgs
+	if !o.start.IsZero() {
+		calArgs = append(calArgs, CalendarSelected(o.start))
+	}
+
+	return o.render("single", "date-picker-"+name+"-content", rootArgs, calArgs,
+		datePickerHidden(name, o.start),
+	)
+}
+
+// DateRangePicker is the range variant of DatePicker, posting the start and end days as ISO dates
+// under startName and endName, with optional DateRangePickerPresets.
+func DateRangePicker(startName, endName string, args ...x.DivArg) x.Node {
+	o, rootArgs := datePickerOptions(args)
+	if o.placeholder == "" {
+		o.placeholder = "Pick a date range"
+	}
+
+	calArgs := o.calendarArgs
+	if !o.start.IsZero() {
+		calArgs = append(calArgs, CalendarRange(o.start, o.end))
+	}
+
+	return o.render("range", "date-range-picker-"+startName+"-content", rootArgs, calArgs,
+		datePickerHidden(startName, o.start),
+		datePickerHidden(endName, o.end),
+	)
+}
+
+// today is the day set with CalendarToday, defaulting to the current day like Calendar
+func (o datePickerOpts) today() time.Time {
+	var co calendarOpts
+	for _, a := range o.calendarArgs {
+		a.(calendarArg).apply(&co)
+	}
+	if co.today.IsZero() {
+		return time.Now()
+	}
+	return co.today
+}
+
+// datePickerOptions splits picker and calendar options from the root's x.DivArg
+func datePickerOptions(args []x.DivArg) (datePickerOpts, []x.DivArg) {
+	var o datePickerOpts
+	var rootArgs []x.DivArg
+	for _, a := range args {
+		switch v := a.(type) {
+		case datePickerArg:
+			v.apply(&o)
+		case calendarArg:
+			o.calendarArgs = append(o.calendarArgs, v)
+		default:
+			rootArgs = append(rootArgs, a)
+		}
+	}
+	return o, rootArgs
+}
+
+func datePickerHidden(name string, t time.Time) x.Node {
+	value := ""
+	if !t.IsZero() {
+		value = t.Format("2006-01-02")
+	}
+	return x.Input(x.InputType("hidden"), x.InputName(name), x.InputValue(value))
+}
+
+func (o datePickerOpts) label() string {
+	if o.start.IsZero() {
+		return o.placeholder
+	}
+	label := o.start.Format("Jan 2, 2006")
+	if !o.end.IsZero() {
+		label += " – " + o.end.Format("Jan 2, 2006")
+	}
+	return label
+}
+
+func (o datePickerOpts) render(mode, contentID string, rootArgs, calArgs []x.DivArg, inputs ...x.Node) x.Node {
+	month := o.start
+	if month.IsZero() {
+		month = o.today()
+	}
+
+	triggerArgs := []x.ButtonArg{
+		ButtonClass(ButtonOutline()),
+		x.Class("w-[260px] font-normal data-[empty=true]:text-muted-foreground"),
+		x.ButtonType("button"),
+		x.Custom("popovertarget", contentID),
+		x.Aria("haspopup", "dialog"),
+		x.Aria("controls", contentID),
+		x.Data("slot", "date-picker-trigger"),
+		lucide.Calendar(),
+		x.Span(x.Class("flex-1 truncate text-left"), x.Data("slot", "date-picker-label"), x.T(o.label())),
+	}
+	if o.start.IsZero() {
+		triggerArgs = append(triggerArgs, x.Data("empty", "true"))
+	}
+
+	panel := []x.DivArg{x.Class("flex")}
+	if len(o.presets) > 0 {
+		list := []x.DivArg{x.Class("flex flex-col gap-1 border-r p-3")}
+		for _, p := range o.presets {
+			list = append(list, x.Button(
+				ButtonClass(ButtonGhost(), ButtonSm()),
+				x.Class("w-full"),
+				x.ButtonType("button"),
+				x.Data("preset-start", p.Start.Format("2006-01-02")),
+				x.Data("preset-end", p.End.Format("2006-01-02")),
+				x.T(p.Label),
+			))
+		}
+		panel = append(panel, x.Div(list...))
+	}
+	calArgs = append([]x.DivArg{CalendarID(contentID + "-calendar")}, calArgs...)
+	panel = append(panel, Calendar(month, append(calArgs, calendarButtons())...))
+
+	content := x.Div(
+		x.Id(contentID),
+		x.Popover("auto"),
+		x.Role("dialog"),
+		x.Aria("label", "Choose date"),
+		x.Class("inset-auto m-0 rounded-md border bg-popover p-0 text-popover-foreground shadow-md"),
+		x.Data("slot", "date-picker-content"),
+		x.Div(panel...),
+	)
+
+	pickerArgs := []x.DivArg{
+		x.Class("inline-block"),
+		x.Data("slot", "date-picker"),
+		x.Data("mode", mode),
+		x.Data("placeholder", o.placeholder),
+	}
+	for _, in := range inputs {
+		pickerArgs = append(pickerArgs, in)
+	}
+	pickerArgs = append(pickerArgs, x.Button(triggerArgs...), content)
+	pickerArgs = append(pickerArgs, rootArgs...)
+
+	return x.Div(pickerArgs...).WithAssets("", datePickerJS, "date-picker")
+}
@@ -0,0 +1,400 @@
+package ui
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	x "github.com/plainkit/html"
+	"github.com/plainkit/icons/lucide"
+)
+
+const fileUploadJS = `(function(){
+  function formatSize(n){
+    const units = ['B','KB','MB','GB'];
+    let i = 0;
+    while(n>=1024 && i<units.length-1){ n /= 1024; i++; }
+    return (i ? n.toFixed(1) : n)+' '+units[i];
+  }
+
+  // same matching rules as the accept attribute: .ext, type/*, exact type
+  function accepts(accept, file){
+    if(!accept) return true;
+    const name = file.name.toLowerCase(), type = (file.type||'').toLowerCase();
+    return accept.split(',').map(s=>s.trim().toLowerCase()).filter(Boolean).some(a=>
+      a.startsWith('.') ? name.endsWith(a) : a.endsWith('/*') ? type.startsWith(a.slice(0, -1)) : type===a);
+  }
+
+  function init(root){
+    if(root.dataset.ready) return;
+    root.dataset.ready = 'true';
+    const input = root.querySelector('input[type="file"]');
+    const dropzone = root.querySelector('[data-file-dropzone]');
+    const list = root.querySelector('[data-file-list]');
+    const tpl = root.querySelector('template[data-file-template]');
+    const maxSize = parseInt(root.dataset.maxSize||'0', 10);
+    const maxFiles = parseInt(root.dataset.maxFiles||'0', 10);
+    const endpoint = root.dataset.uploadUrl;
+    let entries = [];
+    // the chunked input is cleared after every pick, so "required" is checked against upload IDs instead
+    const required = endpoint && input.required;
+    if(required) input.required = false;
+
+    function validate(file, count){
+      if(!accepts(input.accept, file)) return 'File type not allowed';
+      if(maxSize && file.size>maxSize) return 'Larger than '+formatSize(maxSize);
+      if(endpoint && file.size===0) return 'File is empty';
+      if(maxFiles && count>=maxFiles) return 'Too many files (max '+maxFiles+')';
+      return '';
+    }
+
+    // keep the native input in sync so a plain form post sends exactly the listed files
+    function syncInput(){
+      if(endpoint) return;
+      const dt = new DataTransfer();
+      entries.forEach(e=>{ if(!e.error) dt.items.add(e.file); });
+      input.files = dt.files;
+    }
+
+    function remove(entry){
+      if(entry.xhr) entry.xhr.abort();
+      if(entry.preview) URL.revokeObjectURL(entry.preview);
+      if(entry.hidden) entry.hidden.remove();
+      entry.el.remove();
+      entries = entries.filter(e=>e!==entry);
+      syncInput();
+    }
+
+    function render(entry){
+      const el = tpl.content.firstElementChild.cloneNode(true);
+      el.querySelector('[data-file-name]').textContent = entry.file.name;
+      const meta = el.querySelector('[data-file-meta]');
+      meta.textContent = entry.error || formatSize(entry.file.size);
+      if(entry.error) el.dataset.invalid = 'true';
+      if(!entry.error && entry.file.type.startsWith('image/')){
+        entry.preview = URL.createObjectURL(entry.file);
+        const img = el.querySelector('[data-file-preview]');
+        img.src = entry.preview;
+        img.hidden = false;
+        el.querySelector('[data-file-icon]').hidden = true;
+      }
+      el.querySelector('[data-file-remove]').addEventListener('click', ()=>remove(entry));
+      entry.el = el;
+      list.appendChild(el);
+    }
+
+    function setProgress(entry, sent){
+      const bar = entry.el.querySelector('[data-file-progress]');
+      const pct = Math.round(sent/entry.file.size*100);
+      bar.hidden = false;
+      bar.setAttribute('aria-valuenow', String(pct));
+      bar.firstElementChild.style.width = pct+'%';
+    }
+
+    function sendChunk(entry, start, end){
+      return new Promise((resolve, reject)=>{
+        const xhr = entry.xhr = new XMLHttpRequest();
+        xhr.open('POST', endpoint);
+        xhr.setRequestHeader('X-Upload-Id', entry.id);
+        xhr.setRequestHeader('X-Upload-Name', encodeURIComponent(entry.file.name));
+        xhr.setRequestHeader('Content-Range', 'bytes '+start+'-'+(end-1)+'/'+entry.file.size);
+        xhr.upload.onprogress = e=>setProgress(entry, start+e.loaded);
+        xhr.onload = ()=>{
+          let body = {};
+          try { body = JSON.parse(xhr.responseText); } catch(_){}
+          // 409 means the server holds a different offset; resume from there
+          if(xhr.status===200 || xhr.status===409) resolve(body);
+          else reject(new Error(body.error || 'Upload failed ('+xhr.status+')'));
+        };
+        xhr.onerror = ()=>reject(new Error('Network error'));
+        xhr.onabort = ()=>reject(new Error('Cancelled'));
+        xhr.send(entry.file.slice(start, end));
+      });
+    }
+
+    async function upload(entry){
+      const size = parseInt(root.dataset.chunkSize, 10);
+      entry.id = crypto.randomUUID();
+      entry.el.dataset.uploading = 'true';
+      let offset = 0;
+      try {
+        while(true){
+          const res = await sendChunk(entry, offset, Math.min(offset+size, entry.file.size));
+          offset = res.received;
+          if(res.complete) break;
+        }
+        setProgress(entry, entry.file.size);
+        const hidden = document.createElement('input');
+        hidden.type = 'hidden';
+        hidden.name = root.dataset.name;
+        hidden.value = entry.id;
+        root.appendChild(hidden);
+        entry.hidden = hidden;
+      } catch(err){
+        if(!entry.el.isConnected) return;
+        entry.el.dataset.invalid = 'true';
+        entry.el.querySelector('[data-file-meta]').textContent = err.message;
+      } finally {
+        entry.xhr = null;
+        delete entry.el.dataset.uploading;
+      }
+    }
+
+    function add(files){
+      const multiple = input.multiple;
+      if(!multiple) entries.slice().forEach(remove);
+      Array.from(files).slice(0, multiple ? undefined : 1).forEach(file=>{
+        const entry = {file: file, error: validate(file, entries.filter(e=>!e.error).length)};
+        entries.push(entry);
+        render(entry);
+        if(endpoint && !entry.error) upload(entry);
+      });
+      syncInput();
+    }
+
+    input.addEventListener('change', ()=>{
+      const files = Array.from(input.files);
+      if(endpoint) input.value = '';
+      add(files);
+    });
+
+    // the input covers the dropzone, so drops reach it natively; only intercept to merge
+    ['dragenter','dragover'].forEach(t=>dropzone.addEventListener(t, e=>{ e.preventDefault(); dropzone.dataset.dragging = 'true'; }));
+    ['dragleave','drop'].forEach(t=>dropzone.addEventListener(t, ()=>{ delete dropzone.dataset.dragging; }));
+    dropzone.addEventListener('drop', e=>{
+      e.preventDefault();
+      if(!input.disabled) add(e.dataTransfer.files);
+    });
+
+    const form = root.closest('form');
+    if(form) form.addEventListener('submit', e=>{
+      if(root.querySelector('[data-uploading]')){ e.preventDefault(); alert('Please wait for uploads to finish'); }
+      else if(required && !entries.some(en=>en.hidden)){ e.preventDefault(); alert('Please add a file'); input.focus(); }
+    });
+  }
+
+  function initAll(){ document.querySelectorAll('[data-slot="file-upload"]').forEach(init); }
+  if(document.readyState==='loading'){
+    document.addEventListener('DOMContentLoaded', initAll);
+  }else{
+    initAll();
+  }
+})();`
+
+type fileUploadOpts struct {
+	accept    []string
+	maxSize   int64
+	maxFiles  int
+	multiple  bool
+	disabled  bool
+	required  bool
+	uploadURL string
+	chunkSize int64
+	text      string
+}
+
+// Internal wrapper so FileUpload can pick its own options out of x.DivArg
+type fileUploadArg struct {
+	x.Global
+	apply func(*fileUploadOpts)
+}
+
+func newFileUploadArg(apply func(*fileUploadOpts)) x.DivArg {
+	return fileUploadArg{Global: x.Class(""), apply: apply}
+}
+
+// FileUploadAccept restricts the allowed files, using accept attribute syntax (".pdf", "image/*", "text/csv")
+func FileUploadAccept(types ...string) x.DivArg {
+	return newFileUploadArg(func(o *fileUploadOpts) { o.accept = types })
+}
+
+// FileUploadMaxSize rejects files larger than bytes
+func FileUploadMaxSize(bytes int64) x.DivArg {
+	return newFileUploadArg(func(o *fileUploadOpts) { o.maxSize = bytes })
+}
+
+// FileUploadMultiple allows selecting several files; n > 0 caps how many
+func FileUploadMultiple(n int) x.DivArg {
+	return newFileUploadArg(func(o *fileUploadOpts) {
+		o.multiple = true
+		o.maxFiles = n
+	})
+}
+
+// FileUploadRequired requires at least one file
+func FileUploadRequired() x.DivArg {
+	return newFileUploadArg(func(o *fileUploadOpts) { o.required = true })
+}
+
+// FileUploadDisabled disables the dropzone
+func FileUploadDisabled() x.DivArg {
+	return newFileUploadArg(func(o *fileUploadOpts) { o.disabled = true })
+}
+
+// FileUploadText replaces the dropzone prompt
+func FileUploadText(text string) x.DivArg {
+	return newFileUploadArg(func(o *fileUploadOpts) { o.text = text })
+}
+
+// FileUploadChunked uploads each file as soon as it is chosen, in chunkSize pieces POSTed to url
+// with progress bars (see ChunkedUploadHandler). The form then submits one upload ID per file
+// under the field name instead of the file bytes. chunkSize <= 0 uses 1 MiB.
+func FileUploadChunked(url string, chunkSize int64) x.DivArg {
+	return newFileUploadArg(func(o *fileUploadOpts) {
+		o.uploadURL = url
+		o.chunkSize = chunkSize
+		if chunkSize <= 0 {
+			o.chunkSize = 1 << 20
+		}
+	})
+}
+
+// FileUpload renders a drag-and-drop zone around a native file input. Without JavaScript it
+// is a plain file input (dropping onto it still works); the asset adds the file list with sizes,
+// image previews, remove buttons and type/size checks, and the optional chunked upload mode.
+func FileUpload(name string, args ...x.DivArg) x.Node {
+	o := fileUploadOpts{text: "Drag files here or click to browse"}
+	var rootArgs []x.DivArg
+	for _, a := range args {
+		if t, ok := a.(fileUploadArg); ok {
+			t.apply(&o)
+			continue
+		}
+		rootArgs = append(rootArgs, a)
+	}
+
+	uploadArgs := []x.DivArg{
+		x.Class("grid gap-3"),
+		x.Data("slot", "file-upload"),
+		x.Data("name", name),
+	}
+	if o.maxSize > 0 {
+		uploadArgs = append(uploadArgs, x.Data("max-size", strconv.FormatInt(o.maxSize, 10)))
+	}
+	if o.maxFiles > 0 {
+		uploadArgs = append(uploadArgs, x.Data("max-files", strconv.Itoa(o.maxFiles)))
+	}
+	if o.uploadURL != "" {
+		uploadArgs = append(uploadArgs,
+			x.Data("upload-url", o.uploadURL),
+			x.Data("chunk-size", strconv.FormatInt(o.chunkSize, 10)),
+		)
+	}
+
+	uploadArgs = append(uploadArgs,
+		x.Child(o.dropzone(name)),
+		x.Child(x.Ul(x.Class("grid gap-2 empty:hidden"), x.Data("file-list", ""), x.Aria("live", "polite"))),
+		x.Child(x.Template(x.Data("file-template", ""), x.Child(fileUploadItem(o.uploadURL != "")))),
+	)
+	uploadArgs = append(uploadArgs, rootArgs...)
+
+	return x.Div(uploadArgs...).WithAssets("", fileUploadJS, "file-upload")
+}
+
+func (o fileUploadOpts) dropzone(name string) x.Node {
+	inputArgs := []x.InputArg{
+		x.Class("absolute inset-0 size-full cursor-pointer opacity-0 disabled:cursor-not-allowed"),
+		x.InputType("file"),
+	}
+	// In chunked mode the bytes go to the upload endpoint; the form only carries upload IDs
+	if o.uploadURL == "" {
+		inputArgs = append(inputArgs, x.InputName(name))
+	}
+	if len(o.accept) > 0 {
+		inputArgs = append(inputArgs, x.Accept(strings.Join(o.accept, ",")))
+	}
+	if o.multiple {
+		inputArgs = append(inputArgs, x.Multiple())
+	}
+	if o.required {
+		inputArgs = append(inputArgs, x.Required())
+	}
+	if o.disabled {
+		inputArgs = append(inputArgs, x.Disabled())
+	}
+
+	zoneArgs := []x.LabelArg{
+		x.Class("relative flex flex-col items-center justify-center gap-2 rounded-lg border-2 border-dashed border-input px-6 py-8 text-center text-sm transition-colors hover:bg-accent/50 has-[:focus-visible]:border-ring has-[:focus-visible]:ring-[3px] has-[:focus-visible]:ring-ring/50 has-[:disabled]:pointer-events-none has-[:disabled]:opacity-50 data-[dragging=true]:border-primary data-[dragging=true]:bg-accent"),
+		x.Data("file-dropzone", ""),
+		x.Child(x.Input(inputArgs...)),
+		x.Child(x.Span(
+			x.Class("flex size-10 items-center justify-center rounded-full bg-muted text-muted-foreground"),
+			x.Child(lucide.CloudUpload(lucide.Size("20"))),
+		)),
+		x.Child(x.Span(x.Class("font-medium"), x.T(o.text))),
+	}
+	if hint := o.hint(); hint != "" {
+		zoneArgs = append(zoneArgs, x.Child(x.Span(x.Class("text-xs text-muted-foreground"), x.T(hint))))
+	}
+	return x.FormLabel(zoneArgs...)
+}
+
+// hint summarizes the accepted types and limits under the prompt
+func (o fileUploadOpts) hint() string {
+	var parts []string
+	if len(o.accept) > 0 {
+		parts = append(parts, strings.Join(o.accept, ", "))
+	}
+	if o.maxSize > 0 {
+		parts = append(parts, "up to "+formatFileSize(o.maxSize))
+	}
+	if o.maxFiles > 0 {
+		parts = append(parts, "max "+strconv.Itoa(o.maxFiles)+" files")
+	}
+	return strings.Join(parts, " · ")
+}
+
+// fileUploadItem is the list row the asset clones for every chosen file
+func fileUploadItem(chunked bool) x.Node {
+	details := []x.DivArg{
+		x.Class("grid min-w-0 flex-1 gap-1"),
+		x.Child(x.P(x.Class("truncate font-medium"), x.Data("file-name", ""))),
+		x.Child(x.P(x.Class("text-xs text-muted-foreground group-data-[invalid=true]:text-destructive"), x.Data("file-meta", ""))),
+	}
+	if chunked {
+		details = append(details, x.Child(x.Div(
+			x.Class("h-1.5 w-full overflow-hidden rounded-full bg-primary/20"),
+			x.Role("progressbar"),
+			x.Aria("label", "Upload progress"),
+			x.Aria("valuemin", "0"),
+			x.Aria("valuemax", "100"),
+			x.Aria("valuenow", "0"),
+			x.Data("file-progress", ""),
+			x.Hidden(),
+			x.Child(x.Div(x.Class("h-full w-0 bg-primary transition-[width]"))),
+		)))
+	}
+
+	return x.Li(
+		x.Class("group flex items-center gap-3 rounded-md border px-3 py-2 text-sm data-[invalid=true]:border-destructive/50"),
+		x.Data("file-item", ""),
+		x.Child(x.Img(x.Class("size-10 shrink-0 rounded object-cover"), x.Aria("hidden", "true"), x.Data("file-preview", ""), x.Hidden())),
+		x.Child(x.Span(
+			x.Class("flex size-10 shrink-0 items-center justify-center rounded bg-muted text-muted-foreground"),
+			x.Data("file-icon", ""),
+			x.Child(lucide.File(lucide.Size("18"))),
+		)),
+		x.Child(x.Div(details...)),
+		x.Child(Button(
+			ButtonGhost(),
+			ButtonIcon(),
+			x.ButtonType("button"),
+			x.Aria("label", "Remove file"),
+			x.Data("file-remove", ""),
+			x.Child(lucide.X(lucide.Size("14"))),
+		)),
+	)
+}
+
+func formatFileSize(n int64) string {
+	units := []string{"B", "KB", "MB", "GB"}
+	f, i := float64(n), 0
+	for f >= 1024 && i < len(units)-1 {
+		f /= 1024
+		i++
+	}
+	if i == 0 {
+		return fmt.Sprintf("%d %s", n, units[0])
+	}
+	return strings.TrimSuffix(fmt.Sprintf("%.1f", f), ".0") + " " + units[i]
+}
@@ -0,0 +1,226 @@
+package ui
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrUploadNotFound is returned by ChunkedUploadHandler.Open for unknown or unfinished uploads
+var ErrUploadNotFound = errors.New("ui: upload not found")
+
+var uploadIDPattern = regexp.MustCompile(`^[A-Za-z0-9_-]{8,64}$`)
+
+// UploadedFile describes a completed chunked upload
+type UploadedFile struct {
+	ID   string // the upload ID the form submits
+	Name string // the file name reported by the browser
+	Size int64
+	Path string // where the reassembled file lives inside Dir
+}
+
+// ChunkedUploadHandler accepts the chunked uploads sent by FileUpload in FileUploadChunked mode
+// and reassembles them in Dir. Each chunk is a POST with the raw bytes as body, X-Upload-Id
+// naming the upload and Content-Range giving its position; chunks must arrive in order.
+// Register it by pointer, e.g. mux.Handle("/uploads", &ui.ChunkedUploadHandler{Dir: dir}).
+//
+// Uploads are locked individually, so a slow client only holds up its own upload; a second
+// request for an upload that is still being written gets 423 Locked. The handler sets no read
+// deadline, so serve it from an http.Server with ReadTimeout set. Abandoned uploads leave
+// ID.part files behind; call Sweep periodically to remove them.
+//
+// Upload IDs are generated by the browser and the handler doesn't know who sent a file, so it
+// does no access control: authenticate the route, and record the owner of each ID in OnComplete
+// so the handler that receives the form can check it before calling Open.
+type ChunkedUploadHandler struct {
+	// Dir holds partial (ID.part) and completed (ID) uploads; it must exist
+	Dir string
+	// MaxSize rejects uploads whose total size exceeds it; 0 means unlimited
+	MaxSize int64
+	// OnComplete, if set, runs once a file is fully received. Returning an error deletes the
+	// file and reports the error message to the browser. It is the place to record which user
+	// r belongs to, so later requests can't open someone else's upload by ID.
+	OnComplete func(r *http.Request, f UploadedFile) error
+
+	mu   sync.Mutex
+	busy map[string]bool // upload IDs with a request in flight
+}
+
+type uploadStatus struct {
+	ID       string `json:"id"`
+	Received int64  `json:"received"`
+	Complete bool   `json:"complete"`
+	Error    string `json:"error,omitempty"`
+}
+
+func (h *ChunkedUploadHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		writeUploadStatus(w, http.StatusMethodNotAllowed, uploadStatus{Error: "method not allowed"})
+		return
+	}
+
+	id := r.Header.Get("X-Upload-Id")
+	if !uploadIDPattern.MatchString(id) {
+		writeUploadStatus(w, http.StatusBadRequest, uploadStatus{Error: "invalid upload id"})
+		return
+	}
+	var start, end, total int64
+	if _, err := fmt.Sscanf(r.Header.Get("Content-Range"), "bytes %d-%d/%d", &start, &end, &total); err != nil ||
+		start < 0 || end < start || total <= 0 || end >= total {
+		writeUploadStatus(w, http.StatusBadRequest, uploadStatus{ID: id, Error: "invalid Content-Range"})
+		return
+	}
+	if h.MaxSize > 0 && total > h.MaxSize {
+		writeUploadStatus(w, http.StatusRequestEntityTooLarge, uploadStatus{ID: id, Error: "file too large"})
+		return
+	}
+
+	if !h.acquire(id) {
+		writeUploadStatus(w, http.StatusLocked, uploadStatus{ID: id, Error: "upload busy"})
+		return
+	}
+	locked := true
+	defer func() {
+		if locked {
+			h.release(id)
+		}
+	}()
+
+	final := filepath.Join(h.Dir, id)
+	if _, err := os.Stat(final); err == nil {
+		writeUploadStatus(w, http.StatusConflict, uploadStatus{ID: id, Received: total, Complete: true})
+		return
+	}
+
+	part := final + ".part"
+	f, err := os.OpenFile(part, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		writeUploadStatus(w, http.StatusInternalServerError, uploadStatus{ID: id, Error: "cannot store upload"})
+		return
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		writeUploadStatus(w, http.StatusInternalServerError, uploadStatus{ID: id, Error: "cannot store upload"})
+		return
+	}
+	// Chunks are appended, so the only acceptable start is what we already hold
+	if received := info.Size(); start != received {
+		writeUploadStatus(w, http.StatusConflict, uploadStatus{ID: id, Received: received})
+		return
+	}
+
+	want := end - start + 1
+	n, err := io.Copy(f, io.LimitReader(r.Body, want))
+	if err != nil || n != want {
+		// Drop the partial chunk so the client can resend it from start
+		_ = f.Truncate(start)
+		writeUploadStatus(w, http.StatusBadRequest, uploadStatus{ID: id, Received: start, Error: "incomplete chunk"})
+		return
+	}
+	if end+1 < total {
+		writeUploadStatus(w, http.StatusOK, uploadStatus{ID: id, Received: end + 1})
+		return
+	}
+
+	if err := f.Close(); err != nil {
+		writeUploadStatus(w, http.StatusInternalServerError, uploadStatus{ID: id, Error: "cannot store upload"})
+		return
+	}
+	if err := os.Rename(part, final); err != nil {
+		writeUploadStatus(w, http.StatusInternalServerError, uploadStatus{ID: id, Error: "cannot store upload"})
+		return
+	}
+	// The file is complete and no longer written to; don't hold the upload while OnComplete runs
+	h.release(id)
+	locked = false
+
+	if h.OnComplete != nil {
+		name, _ := url.QueryUnescape(r.Header.Get("X-Upload-Name"))
+		uploaded := UploadedFile{ID: id, Name: filepath.Base(name), Size: total, Path: final}
+		if err := h.OnComplete(r, uploaded); err != nil {
+			_ = os.Remove(final)
+			writeUploadStatus(w, http.StatusUnprocessableEntity, uploadStatus{ID: id, Error: err.Error()})
+			return
+		}
+	}
+	writeUploadStatus(w, http.StatusOK, uploadStatus{ID: id, Received: total, Complete: true})
+}
+
+// acquire marks id busy, reporting false if another request already holds it
+func (h *ChunkedUploadHandler) acquire(id string) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.busy[id] {
+		return false
+	}
+	if h.busy == nil {
+		h.busy = map[string]bool{}
+	}
+	h.busy[id] = true
+	return true
+}
+
+func (h *ChunkedUploadHandler) release(id string) {
+	h.mu.Lock()
+	delete(h.busy, id)
+	h.mu.Unlock()
+}
+
+// Sweep removes partial uploads that have not received a chunk for longer than maxAge
+func (h *ChunkedUploadHandler) Sweep(maxAge time.Duration) error {
+	entries, err := os.ReadDir(h.Dir)
+	if err != nil {
+		return err
+	}
+	cutoff := time.Now().Add(-maxAge)
+	for _, e := range entries {
+		id := strings.TrimSuffix(e.Name(), ".part")
+		if id == e.Name() || !uploadIDPattern.MatchString(id) {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil || info.ModTime().After(cutoff) {
+			continue
+		}
+		if !h.acquire(id) {
+			continue
+		}
+		err = os.Remove(filepath.Join(h.Dir, e.Name()))
+		h.release(id)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+	return nil
+}
+
+// Open returns a completed upload by the ID a FileUpload form submitted. Anyone can submit any
+// ID, so check that it belongs to the current user (see ChunkedUploadHandler) before opening it.
+func (h *ChunkedUploadHandler) Open(id string) (*os.File, error) {
+	if !uploadIDPattern.MatchString(id) {
+		return nil, ErrUploadNotFound
+	}
+	f, err := os.Open(filepath.Join(h.Dir, id))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrUploadNotFound
+	}
+	return f, err
+}
+
+func writeUploadStatus(w http.ResponseWriter, status int, s uploadStatus) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(s)
+}
@@ -0,0 +1,147 @@
+package ui
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testUploadID = "upload-0001"
+
+func sendChunk(t *testing.T, h http.Handler, id string, body string, start, end, total int64) (int, uploadStatus) {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodPost, "/uploads", strings.NewReader(body))
+	req.Header.Set("X-Upload-Id", id)
+	req.Header.Set("X-Upload-Name", "notes%20final.txt")
+	req.Header.Set("Content-Range", "bytes "+strconv.FormatInt(start, 10)+"-"+strconv.FormatInt(end, 10)+"/"+strconv.FormatInt(total, 10))
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	var s uploadStatus
+	if err := json.NewDecoder(rec.Body).Decode(&s); err != nil {
+		t.Fatalf("decode status: %v", err)
+	}
+	return rec.Code, s
+}
+
+func TestChunkedUploadInOrder(t *testing.T) {
+	var got UploadedFile
+	h := &ChunkedUploadHandler{Dir: t.TempDir(), OnComplete: func(r *http.Request, f UploadedFile) error {
+		got = f
+		return nil
+	}}
+
+	if code, s := sendChunk(t, h, testUploadID, "hello ", 0, 5, 11); code != http.StatusOK || s.Received != 6 || s.Complete {
+		t.Fatalf("first chunk: %d %+v", code, s)
+	}
+	if code, s := sendChunk(t, h, testUploadID, "world", 6, 10, 11); code != http.StatusOK || s.Received != 11 || !s.Complete {
+		t.Fatalf("last chunk: %d %+v", code, s)
+	}
+
+	if got.ID != testUploadID || got.Name != "notes final.txt" || got.Size != 11 {
+		t.Fatalf("OnComplete got %+v", got)
+	}
+	f, err := h.Open(testUploadID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if b, _ := io.ReadAll(f); string(b) != "hello world" {
+		t.Fatalf("content = %q", b)
+	}
+	if _, err := os.Stat(filepath.Join(h.Dir, testUploadID+".part")); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("part file left behind: %v", err)
+	}
+
+	// a completed upload is not reopened
+	if code, s := sendChunk(t, h, testUploadID, "hello ", 0, 5, 11); code != http.StatusConflict || !s.Complete {
+		t.Fatalf("resend after completion: %d %+v", code, s)
+	}
+}
+
+func TestChunkedUploadOffsetMismatch(t *testing.T) {
+	h := &ChunkedUploadHandler{Dir: t.TempDir()}
+	sendChunk(t, h, testUploadID, "hello ", 0, 5, 11)
+
+	code, s := sendChunk(t, h, testUploadID, "rld", 8, 10, 11)
+	if code != http.StatusConflict || s.Received != 6 || s.Complete {
+		t.Fatalf("skipped chunk: %d %+v", code, s)
+	}
+	code, s = sendChunk(t, h, testUploadID, "hello ", 0, 5, 11)
+	if code != http.StatusConflict || s.Received != 6 {
+		t.Fatalf("repeated chunk: %d %+v", code, s)
+	}
+}
+
+func TestChunkedUploadShortBody(t *testing.T) {
+	h := &ChunkedUploadHandler{Dir: t.TempDir()}
+	sendChunk(t, h, testUploadID, "hello ", 0, 5, 11)
+
+	code, s := sendChunk(t, h, testUploadID, "wor", 6, 10, 11)
+	if code != http.StatusBadRequest || s.Received != 6 {
+		t.Fatalf("short chunk: %d %+v", code, s)
+	}
+	// the partial bytes are dropped so the chunk can be resent whole
+	if info, err := os.Stat(filepath.Join(h.Dir, testUploadID+".part")); err != nil || info.Size() != 6 {
+		t.Fatalf("part file after short chunk: %v %v", info, err)
+	}
+	if code, s := sendChunk(t, h, testUploadID, "world", 6, 10, 11); code != http.StatusOK || !s.Complete {
+		t.Fatalf("resent chunk: %d %+v", code, s)
+	}
+}
+
+func TestChunkedUploadMaxSize(t *testing.T) {
+	h := &ChunkedUploadHandler{Dir: t.TempDir(), MaxSize: 10}
+
+	code, s := sendChunk(t, h, testUploadID, "hello ", 0, 5, 11)
+	if code != http.StatusRequestEntityTooLarge || s.Error == "" {
+		t.Fatalf("oversized upload: %d %+v", code, s)
+	}
+	if _, err := os.Stat(filepath.Join(h.Dir, testUploadID+".part")); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("oversized upload was stored: %v", err)
+	}
+	if code, _ := sendChunk(t, h, testUploadID, "hello", 0, 4, 10); code != http.StatusOK {
+		t.Fatalf("upload at MaxSize: %d", code)
+	}
+}
+
+func TestChunkedUploadBusy(t *testing.T) {
+	h := &ChunkedUploadHandler{Dir: t.TempDir()}
+	h.acquire(testUploadID)
+
+	if code, _ := sendChunk(t, h, testUploadID, "hello ", 0, 5, 11); code != http.StatusLocked {
+		t.Fatalf("concurrent chunk: %d", code)
+	}
+	// other uploads are unaffected
+	if code, _ := sendChunk(t, h, "upload-0002", "hello ", 0, 5, 11); code != http.StatusOK {
+		t.Fatalf("other upload: %d", code)
+	}
+}
+
+func TestChunkedUploadSweep(t *testing.T) {
+	h := &ChunkedUploadHandler{Dir: t.TempDir()}
+	sendChunk(t, h, testUploadID, "hello ", 0, 5, 11)
+	sendChunk(t, h, "upload-0002", "hello ", 0, 5, 11)
+	old := time.Now().Add(-2 * time.Hour)
+	if err := os.Chtimes(filepath.Join(h.Dir, testUploadID+".part"), old, old); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := h.Sweep(time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(filepath.Join(h.Dir, testUploadID+".part")); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("stale part file kept: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(h.Dir, "upload-0002.part")); err != nil {
+		t.Fatalf("fresh part file removed: %v", err)
+	}
+}
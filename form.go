@@ -0,0 +1,581 @@
+package ui
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+
+	x "github.com/plainkit/html"
+)
+
+// formField describes a single struct field as configured by its `ui` tag
+type formField struct {
+	name        string
+	id          string
+	label       string
+	kind        string // input type, or "textarea", "select", "radio", "checkbox", "switch"
+	placeholder string
+	help        string
+	options     []formOption
+	rows        int
+	required    bool
+	readonly    bool
+	disabled    bool
+	min         string // length bounds for strings, value bounds for numbers
+	max         string
+	pattern     string
+	patternRE   *regexp.Regexp // pattern anchored like the HTML attribute
+	email       bool
+	value       reflect.Value
+	err         string
+}
+
+type formOption struct {
+	value string
+	label string
+}
+
+// Form renders a complete form from a tagged struct (or pointer to one), prefilled with
+// the struct's current values. Fields are configured with a `ui` struct tag:
+//
+//	type Profile struct {
+//	    Email string `ui:"label=Email,type=email,required,placeholder=you@example.com"`
+//	    Bio   string `ui:"type=textarea,rows=4,help=Shown on your public profile"`
+//	    Plan  string `ui:"type=radio,options=free:Free|pro:Pro"`
+//	    Role  string `ui:"options=admin:Admin|member:Member"`
+//	    Terms bool   `ui:"label=I accept the terms"`
+//	}
+//
+// Keys: label, name, id, type, placeholder, help, options (value:Label pairs separated by |),
+// rows, readonly (not for select or radio, which can't block changes; use disabled), disabled, plus the validation rules shared with DecodeForm: required, min, max,
+// pattern, email and oneof (an alias of options). Use `ui:"-"` to skip a field. Tag values cannot contain commas.
+// Without a type, bool fields render a Checkbox (type=switch renders a Switch), numbers a number Input, fields with options a Select,
+// and everything else a text Input. Names default to the snake_cased field name.
+// Pass x.Action, x.Method, classes and trailing children (e.g. a submit Button via x.Child) as x.FormArg,
+// and FormErrors to mark invalid fields and render their messages.
+func Form(v interface{}, args ...x.FormArg) x.Node {
+	var errs FieldErrors
+	for _, a := range args {
+		if e, ok := a.(formErrorsArg); ok {
+			errs = e.errs
+		}
+	}
+
+	formArgs := []x.FormArg{x.Class("grid gap-6")}
+	for _, f := range formFields(v) {
+		f.err = errs[f.name]
+		formArgs = append(formArgs, f.render())
+	}
+	formArgs = append(formArgs, args...)
+
+	return x.Form(formArgs...)
+}
+
+// FormItem groups a label, a control and its description. Strictly accepts x.DivArg.
+func FormItem(args ...x.DivArg) x.Node {
+	classes := "grid gap-2"
+	itemArgs := []x.DivArg{x.Class(classes), x.Data("slot", "form-item")}
+	itemArgs = append(itemArgs, args...)
+	return x.Div(itemArgs...)
+}
+
+// FormDescription renders helper text for a control. Strictly accepts x.PArg.
+func FormDescription(args ...x.PArg) x.Node {
+	classes := "text-muted-foreground text-sm"
+	descArgs := []x.PArg{x.Class(classes), x.Data("slot", "form-description")}
+	descArgs = append(descArgs, args...)
+	return x.P(descArgs...)
+}
+
+// FormMessage renders a validation message for a control. Strictly accepts x.PArg.
+func FormMessage(args ...x.PArg) x.Node {
+	classes := "text-destructive text-sm font-medium"
+	msgArgs := []x.PArg{x.Class(classes), x.Data("slot", "form-message")}
+	msgArgs = append(msgArgs, args...)
+	return x.P(msgArgs...)
+}
+
+// FieldErrors maps a field's form name to its validation message
+type FieldErrors map[string]string
+
+type formErrorsArg struct {
+	x.Global
+	errs FieldErrors
+}
+
+// FormErrors passes the errors returned by DecodeForm into Form, which sets aria-invalid
+// on the affected controls and renders a FormMessage below each of them.
+func FormErrors(errs FieldErrors) x.FormArg {
+	return formErrorsArg{Global: x.Class(""), errs: errs}
+}
+
+// formFields reflects over a struct (or pointer to struct) and parses its `ui` tags.
+// Passing anything else, a pattern Go's regexp package can't compile, or a readonly select or
+// radio field is a programming error and panics.
+func formFields(v interface{}) []formField {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("ui: Form expects a struct or pointer to struct, got %T", v))
+	}
+	return collectFormFields(rv)
+}
+
+func collectFormFields(rv reflect.Value) []formField {
+	var fields []formField
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		sf := rt.Field(i)
+		tag, hasTag := sf.Tag.Lookup("ui")
+		if tag == "-" {
+			continue
+		}
+		// Embedded structs contribute their fields inline
+		if sf.Anonymous && !hasTag && sf.Type.Kind() == reflect.Struct {
+			fields = append(fields, collectFormFields(rv.Field(i))...)
+			continue
+		}
+		if sf.PkgPath != "" || !isFormKind(sf.Type.Kind()) {
+			continue
+		}
+		fields = append(fields, parseFormField(sf, tag, rv.Field(i)))
+	}
+	return fields
+}
+
+func isFormKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+func parseFormField(sf reflect.StructField, tag string, fv reflect.Value) formField {
+	words := fieldWords(sf.Name)
+	f := formField{
+		name:  strings.ToLower(strings.Join(words, "_")),
+		label: strings.Join(words, " "),
+		value: fv,
+	}
+
+	for _, part := range strings.Split(tag, ",") {
+		key, val, _ := strings.Cut(strings.TrimSpace(part), "=")
+		switch key {
+		case "label":
+			f.label = val
+		case "name":
+			f.name = val
+		case "id":
+			f.id = val
+		case "type":
+			f.kind = val
+		case "placeholder":
+			f.placeholder = val
+		case "help":
+			f.help = val
+		case "options", "oneof":
+			f.options = parseFormOptions(val)
+		case "rows":
+			f.rows, _ = strconv.Atoi(val)
+		case "required":
+			f.required = true
+		case "min":
+			f.min = val
+		case "max":
+			f.max = val
+		case "pattern":
+			re, err := compileFormPattern(val)
+			if err != nil {
+				panic(fmt.Sprintf("ui: field %s: invalid pattern %q: %v", sf.Name, val, err))
+			}
+			f.pattern, f.patternRE = val, re
+		case "email":
+			f.email = true
+		case "readonly":
+			f.readonly = true
+		case "disabled":
+			f.disabled = true
+		}
+	}
+
+	if f.id == "" {
+		f.id = f.name
+	}
+	if f.kind == "" && f.email {
+		f.kind = "email"
+	}
+	if f.kind == "" {
+		f.kind = defaultFormKind(fv.Kind(), len(f.options) > 0)
+	}
+	f.email = f.email || f.kind == "email"
+	if f.readonly && (f.kind == "select" || f.kind == "radio") {
+		panic(fmt.Sprintf("ui: field %s: readonly is not supported for type %s; use disabled", sf.Name, f.kind))
+	}
+	return f
+}
+
+// formPatterns caches compiled tag patterns; struct tags are fixed, so the set stays small
+var formPatterns sync.Map
+
+// compileFormPattern anchors pattern like the HTML attribute and compiles it once per pattern.
+// Patterns Go's RE2 can't parse (e.g. lookaheads) are rejected so client and server rules can't diverge.
+func compileFormPattern(pattern string) (*regexp.Regexp, error) {
+	if re, ok := formPatterns.Load(pattern); ok {
+		return re.(*regexp.Regexp), nil
+	}
+	re, err := regexp.Compile("^(?:" + pattern + ")$")
+	if err != nil {
+		return nil, err
+	}
+	formPatterns.Store(pattern, re)
+	return re, nil
+}
+
+func defaultFormKind(k reflect.Kind, hasOptions bool) string {
+	switch {
+	case k == reflect.Bool:
+		return "checkbox"
+	case hasOptions:
+		return "select"
+	case isNumberKind(k):
+		return "number"
+	}
+	return "text"
+}
+
+func isNumberKind(k reflect.Kind) bool {
+	return k != reflect.String && k != reflect.Bool && isFormKind(k)
+}
+
+// parseFormOptions parses "a:Label A|b:Label B"; a missing label falls back to the value
+func parseFormOptions(s string) []formOption {
+	var opts []formOption
+	for _, item := range strings.Split(s, "|") {
+		if item == "" {
+			continue
+		}
+		value, label, ok := strings.Cut(item, ":")
+		if !ok {
+			label = value
+		}
+		opts = append(opts, formOption{value: value, label: label})
+	}
+	return opts
+}
+
+// fieldWords splits a Go identifier into words: "HomeURLPath" -> ["Home", "URL", "Path"]
+func fieldWords(s string) []string {
+	runes := []rune(s)
+	var words []string
+	start := 0
+	for i := 1; i < len(runes); i++ {
+		if !unicode.IsUpper(runes[i]) {
+			continue
+		}
+		prev := runes[i-1]
+		nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+		if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+			words = append(words, string(runes[start:i]))
+			start = i
+		}
+	}
+	return append(words, string(runes[start:]))
+}
+
+// stringValue formats the field's current value for prefilling a control
+func (f formField) stringValue() string {
+	v := f.value
+	switch v.Kind() {
+	case reflect.String:
+		return v.String()
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, v.Type().Bits())
+	}
+	return ""
+}
+
+func (f formField) descriptionID() string { return f.id + "-description" }
+
+func (f formField) messageID() string { return f.id + "-message" }
+
+// describedBy lists the ids of the description and error message, if any
+func (f formField) describedBy() string {
+	var ids []string
+	if f.help != "" {
+		ids = append(ids, f.descriptionID())
+	}
+	if f.err != "" {
+		ids = append(ids, f.messageID())
+	}
+	return strings.Join(ids, " ")
+}
+
+// feedback returns the description and error message nodes shown below a control
+func (f formField) feedback() []x.Node {
+	var nodes []x.Node
+	if f.help != "" {
+		nodes = append(nodes, FormDescription(x.Id(f.descriptionID()), x.T(f.help)))
+	}
+	if f.err != "" {
+		nodes = append(nodes, FormMessage(x.Id(f.messageID()), x.T(f.err)))
+	}
+	return nodes
+}
+
+// lengthBound parses a min/max rule as a character count
+func lengthBound(s string) int {
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 0 {
+		return 0
+	}
+	return n
+}
+
+func (f formField) render() x.Node {
+	switch f.kind {
+	case "hidden":
+		return x.Input(x.InputType("hidden"), x.InputName(f.name), x.InputValue(f.stringValue()))
+	case "checkbox", "switch":
+		return f.renderCheckbox()
+	case "radio":
+		return f.renderRadio()
+	}
+
+	var control x.Node
+	switch f.kind {
+	case "textarea":
+		control = f.renderTextarea()
+	case "select":
+		control = f.renderSelect()
+	default:
+		control = f.renderInput()
+	}
+
+	itemArgs := []x.DivArg{Label(x.For(f.id), x.T(f.label)), control}
+	for _, n := range f.feedback() {
+		itemArgs = append(itemArgs, n)
+	}
+	return FormItem(itemArgs...)
+}
+
+func (f formField) renderInput() x.Node {
+	inputArgs := []x.InputArg{
+		x.Id(f.id),
+		x.InputName(f.name),
+		x.InputType(f.kind),
+		x.InputValue(f.stringValue()),
+	}
+	if f.kind == "number" && (f.value.Kind() == reflect.Float32 || f.value.Kind() == reflect.Float64) {
+		inputArgs = append(inputArgs, x.Step("any"))
+	}
+	if isNumberKind(f.value.Kind()) {
+		if f.min != "" {
+			inputArgs = append(inputArgs, x.Min(f.min))
+		}
+		if f.max != "" {
+			inputArgs = append(inputArgs, x.Max(f.max))
+		}
+	} else {
+		if n := lengthBound(f.min); n > 0 {
+			inputArgs = append(inputArgs, x.Minlength(n))
+		}
+		if n := lengthBound(f.max); n > 0 {
+			inputArgs = append(inputArgs, x.Maxlength(n))
+		}
+		if f.pattern != "" {
+			inputArgs = append(inputArgs, x.Pattern(f.pattern))
+		}
+	}
+	if f.placeholder != "" {
+		inputArgs = append(inputArgs, x.Placeholder(f.placeholder))
+	}
+	if f.required {
+		inputArgs = append(inputArgs, x.Required())
+	}
+	if f.readonly {
+		inputArgs = append(inputArgs, x.Readonly())
+	}
+	if f.disabled {
+		inputArgs = append(inputArgs, x.Disabled())
+	}
+	if ids := f.describedBy(); ids != "" {
+		inputArgs = append(inputArgs, x.Aria("describedby", ids))
+	}
+	if f.err != "" {
+		inputArgs = append(inputArgs, x.Aria("invalid", "true"))
+	}
+	return Input(inputArgs...)
+}
+
+func (f formField) renderTextarea() x.Node {
+	textareaArgs := []x.TextareaArg{
+		x.Id(f.id),
+		x.TextareaName(f.name),
+		x.T(f.stringValue()),
+	}
+	if f.rows > 0 {
+		textareaArgs = append(textareaArgs, x.Rows(f.rows))
+	}
+	if n := lengthBound(f.min); n > 0 {
+		textareaArgs = append(textareaArgs, x.Minlength(n))
+	}
+	if n := lengthBound(f.max); n > 0 {
+		textareaArgs = append(textareaArgs, x.Maxlength(n))
+	}
+	if f.placeholder != "" {
+		textareaArgs = append(textareaArgs, x.Placeholder(f.placeholder))
+	}
+	if f.required {
+		textareaArgs = append(textareaArgs, x.Required())
+	}
+	if f.readonly {
+		textareaArgs = append(textareaArgs, x.Readonly())
+	}
+	if f.disabled {
+		textareaArgs = append(textareaArgs, x.Disabled())
+	}
+	if ids := f.describedBy(); ids != "" {
+		textareaArgs = append(textareaArgs, x.Aria("describedby", ids))
+	}
+	if f.err != "" {
+		textareaArgs = append(textareaArgs, x.Aria("invalid", "true"))
+	}
+	return Textarea(textareaArgs...)
+}
+
+func (f formField) renderSelect() x.Node {
+	current := f.stringValue()
+	selectArgs := []x.SelectArg{
+		x.Id(f.id),
+		x.Custom("name", f.name),
+	}
+	if f.required {
+		selectArgs = append(selectArgs, x.Required())
+	}
+	if f.disabled {
+		selectArgs = append(selectArgs, x.Disabled())
+	}
+	if ids := f.describedBy(); ids != "" {
+		selectArgs = append(selectArgs, x.Aria("describedby", ids))
+	}
+	if f.err != "" {
+		selectArgs = append(selectArgs, x.Aria("invalid", "true"))
+	}
+
+	// Placeholder option is shown until a value is picked but can't be submitted
+	if f.placeholder != "" {
+		placeholderArgs := []x.OptionArg{x.Disabled(), x.Hidden(), x.T(f.placeholder)}
+		if current == "" {
+			placeholderArgs = append(placeholderArgs, x.Selected())
+		}
+		selectArgs = append(selectArgs, x.Child(x.Option(placeholderArgs...)))
+	}
+
+	for _, opt := range f.options {
+		optionArgs := []x.OptionArg{x.Custom("value", opt.value), x.T(opt.label)}
+		if opt.value == current {
+			optionArgs = append(optionArgs, x.Selected())
+		}
+		selectArgs = append(selectArgs, x.Child(x.Option(optionArgs...)))
+	}
+	return Select(selectArgs...)
+}
+
+func (f formField) renderCheckbox() x.Node {
+	inputArgs := []x.InputArg{
+		x.Id(f.id),
+		x.InputName(f.name),
+		x.InputValue("true"),
+	}
+	if f.value.Kind() == reflect.Bool && f.value.Bool() {
+		inputArgs = append(inputArgs, x.Checked())
+	}
+	if f.required {
+		inputArgs = append(inputArgs, x.Required())
+	}
+	if f.readonly {
+		inputArgs = append(inputArgs, x.Readonly())
+	}
+	if f.disabled {
+		inputArgs = append(inputArgs, x.Disabled())
+	}
+	if ids := f.describedBy(); ids != "" {
+		inputArgs = append(inputArgs, x.Aria("describedby", ids))
+	}
+	if f.err != "" {
+		inputArgs = append(inputArgs, x.Aria("invalid", "true"))
+	}
+
+	control := Checkbox(inputArgs...)
+	if f.kind == "switch" {
+		control = Switch(inputArgs...)
+	}
+
+	itemArgs := []x.DivArg{
+		x.Div(
+			x.Class("flex items-center gap-2"),
+			control,
+			Label(x.For(f.id), x.T(f.label)),
+		),
+	}
+	for _, n := range f.feedback() {
+		itemArgs = append(itemArgs, n)
+	}
+	return FormItem(itemArgs...)
+}
+
+func (f formField) renderRadio() x.Node {
+	current := f.stringValue()
+	groupArgs := []interface{}{x.Role("radiogroup")}
+	if f.err != "" {
+		groupArgs = append(groupArgs, x.Aria("invalid", "true"))
+	}
+	for i, opt := range f.options {
+		radioArgs := []interface{}{
+			x.Id(f.id + "-" + strconv.Itoa(i)),
+			x.InputName(f.name),
+			x.InputValue(opt.value),
+			RadioLabel(opt.label),
+		}
+		if opt.value == current {
+			radioArgs = append(radioArgs, x.Checked())
+		}
+		if f.required {
+			radioArgs = append(radioArgs, x.Required())
+		}
+		if f.disabled {
+			radioArgs = append(radioArgs, x.Disabled())
+		}
+		groupArgs = append(groupArgs, x.Child(Radio(radioArgs...)))
+	}
+
+	fieldsetArgs := []x.FieldsetArg{
+		x.Class("grid gap-3"),
+		x.Id(f.id),
+		x.Legend(x.Class("text-sm leading-none font-medium mb-1"), x.T(f.label)),
+		x.Child(RadioGroup(groupArgs...)),
+	}
+	if ids := f.describedBy(); ids != "" {
+		fieldsetArgs = append(fieldsetArgs, x.Aria("describedby", ids))
+	}
+	for _, n := range f.feedback() {
+		fieldsetArgs = append(fieldsetArgs, n)
+	}
+	return x.Fieldset(fieldsetArgs...)
+}
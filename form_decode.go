@@ -0,0 +1,138 @@
+package ui
+
+import (
+	"fmt"
+	"math"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strconv"
+	"unicode/utf8"
+)
+
+// emailPattern is the WHATWG "valid e-mail address" production used by type="email"
+var emailPattern = regexp.MustCompile("^[a-zA-Z0-9.!#$%&'*+/=?^_`{|}~-]+@[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?(?:\\.[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)*$")
+
+// DecodeForm decodes posted values into a pointer to a `ui`-tagged struct and validates them
+// with the same tags Form uses for HTML constraint attributes, so client and server rules can't diverge.
+// Rules: required, min/max (length for strings, value for numbers), pattern (anchored like the
+// HTML attribute), email, and oneof/options. Empty optional fields are not validated.
+// Disabled and readonly fields keep their current value. Returns nil when every field is valid;
+// otherwise pass the result to Form via FormErrors.
+func DecodeForm(values url.Values, v interface{}) FieldErrors {
+	if rv := reflect.ValueOf(v); rv.Kind() != reflect.Ptr || rv.IsNil() {
+		panic(fmt.Sprintf("ui: DecodeForm expects a non-nil pointer to struct, got %T", v))
+	}
+
+	var errs FieldErrors
+	for _, f := range formFields(v) {
+		if f.disabled || f.readonly || !f.value.CanSet() {
+			continue
+		}
+		if msg := f.decode(values.Get(f.name)); msg != "" {
+			if errs == nil {
+				errs = FieldErrors{}
+			}
+			errs[f.name] = msg
+		}
+	}
+	return errs
+}
+
+// decode stores raw into the field and returns a validation message, or "" if valid
+func (f formField) decode(raw string) string {
+	kind := f.value.Kind()
+	if kind == reflect.Bool {
+		checked := raw != "" && raw != "false"
+		f.value.SetBool(checked)
+		if f.required && !checked {
+			return "This field is required."
+		}
+		return ""
+	}
+
+	if raw == "" {
+		f.value.Set(reflect.Zero(f.value.Type()))
+		if f.required {
+			return "This field is required."
+		}
+		return ""
+	}
+
+	switch {
+	case kind == reflect.String:
+		f.value.SetString(raw)
+		if msg := f.validateString(raw); msg != "" {
+			return msg
+		}
+	case kind >= reflect.Int && kind <= reflect.Int64:
+		n, err := strconv.ParseInt(raw, 10, f.value.Type().Bits())
+		if err != nil {
+			return "Enter a whole number."
+		}
+		f.value.SetInt(n)
+		if msg := f.validateRange(float64(n)); msg != "" {
+			return msg
+		}
+	case kind >= reflect.Uint && kind <= reflect.Uint64:
+		n, err := strconv.ParseUint(raw, 10, f.value.Type().Bits())
+		if err != nil {
+			return "Enter a whole number."
+		}
+		f.value.SetUint(n)
+		if msg := f.validateRange(float64(n)); msg != "" {
+			return msg
+		}
+	case kind == reflect.Float32 || kind == reflect.Float64:
+		n, err := strconv.ParseFloat(raw, f.value.Type().Bits())
+		// ParseFloat accepts "NaN" and "Inf", which no number input submits and every bound check passes
+		if err != nil || math.IsNaN(n) || math.IsInf(n, 0) {
+			return "Enter a number."
+		}
+		f.value.SetFloat(n)
+		if msg := f.validateRange(n); msg != "" {
+			return msg
+		}
+	}
+
+	if len(f.options) > 0 && !f.hasOption(raw) {
+		return "Select a valid option."
+	}
+	return ""
+}
+
+func (f formField) validateString(s string) string {
+	n := utf8.RuneCountInString(s)
+	if min := lengthBound(f.min); min > 0 && n < min {
+		return fmt.Sprintf("Must be at least %d characters.", min)
+	}
+	if max := lengthBound(f.max); max > 0 && n > max {
+		return fmt.Sprintf("Must be at most %d characters.", max)
+	}
+	if f.email && !emailPattern.MatchString(s) {
+		return "Enter a valid email address."
+	}
+	if f.patternRE != nil && !f.patternRE.MatchString(s) {
+		return "Match the requested format."
+	}
+	return ""
+}
+
+func (f formField) validateRange(n float64) string {
+	if min, err := strconv.ParseFloat(f.min, 64); err == nil && n < min {
+		return "Must be at least " + f.min + "."
+	}
+	if max, err := strconv.ParseFloat(f.max, 64); err == nil && n > max {
+		return "Must be at most " + f.max + "."
+	}
+	return ""
+}
+
+func (f formField) hasOption(value string) bool {
+	for _, opt := range f.options {
+		if opt.value == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,59 @@
+package ui
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	x "github.com/plainkit/html"
+)
+
+// treeJS concatenates every asset script in c's tree; x.Assets skips repeated names, including
+// the empty name of plain elements, so it can't be used on a nested tree
+func treeJS(c x.Component) string {
+	var js string
+	if n, ok := c.(x.Node); ok {
+		js = n.JS()
+		for _, k := range n.Children() {
+			js += treeJS(k)
+		}
+	}
+	return js
+}
+
+func TestFormReadonlyBool(t *testing.T) {
+	type settings struct {
+		Terms  bool `ui:"readonly"`
+		Alerts bool `ui:"type=switch,readonly"`
+	}
+	form := Form(settings{Terms: true})
+	html := x.Render(form)
+	js := treeJS(form)
+
+	for _, id := range []string{"terms", "alerts"} {
+		input := regexp.MustCompile(`<input [^>]*id="` + id + `"[^>]*>`).FindString(html)
+		if !strings.Contains(input, `aria-readonly="true"`) || !strings.Contains(input, "readonly") {
+			t.Errorf("%s not marked readonly: %s", id, input)
+		}
+		// the wrapping label takes the clicks; it must not pass them on to the input
+		label := regexp.MustCompile(`<label [^>]*class="[^"]*"[^>]*>\s*<input [^>]*id="` + id + `"`).FindString(html)
+		if !strings.Contains(label, "has-[[aria-readonly=true]]:pointer-events-none") {
+			t.Errorf("%s still takes clicks: %s", id, label)
+		}
+	}
+	if !strings.Contains(js, `input[type="checkbox"][aria-readonly="true"]`) || !strings.Contains(js, `[data-slot="switch"] > input[aria-readonly="true"]`) {
+		t.Error("keyboard guard asset missing")
+	}
+}
+
+func TestFormReadonlyRejectedForChoices(t *testing.T) {
+	type plan struct {
+		Plan string `ui:"type=radio,options=free:Free|pro:Pro,readonly"`
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("readonly radio field did not panic")
+		}
+	}()
+	Form(plan{})
+}
@@ -0,0 +1,56 @@
+package ui
+
+import x "github.com/plainkit/html"
+
+// Internal marker so InputGroupAddon can drop its background and separator
+type inputGroupInlineArg struct{ x.Global }
+
+// InputGroupInline renders an addon inside the field without a background or separator,
+// e.g. a search icon or a unit that reads as part of the value
+func InputGroupInline() x.DivArg {
+	return inputGroupInlineArg{Global: x.Class("")}
+}
+
+// InputGroupAddon renders a leading or trailing segment (text such as "https://", an icon, a kbd hint).
+// Its position is simply where it sits among the InputGroup children.
+func InputGroupAddon(args ...x.DivArg) x.Node {
+	classes := "flex shrink-0 items-center gap-1.5 px-3 text-sm text-muted-foreground [&_svg]:size-4 [&_svg]:shrink-0"
+	var inline bool
+	addonArgs := make([]x.DivArg, 0, len(args)+2)
+	for _, a := range args {
+		if _, ok := a.(inputGroupInlineArg); ok {
+			inline = true
+			continue
+		}
+		addonArgs = append(addonArgs, a)
+	}
+	if inline {
+		// Sits against the input, which keeps its own padding on that side
+		classes += " pointer-events-none first:pr-0 last:pl-0"
+	} else {
+		classes += " bg-muted border-input first:border-r last:border-l"
+	}
+
+	return x.Div(append([]x.DivArg{x.Class(classes), x.Data("slot", "input-group-addon")}, addonArgs...)...)
+}
+
+// InputGroup joins Input, Select, Button and InputGroupAddon children into one bordered control.
+// Children lose their own borders, radius and focus ring; the group draws a single border and
+// shows the ring while any child has focus. Pass children via x.Child.
+func InputGroup(args ...x.DivArg) x.Node {
+	group := "flex h-9 w-full items-stretch overflow-hidden rounded-md border border-input bg-background dark:bg-input/30 shadow-xs transition-[color,box-shadow] focus-within:border-ring focus-within:ring-[3px] focus-within:ring-ring/50 has-[[aria-invalid=true]]:border-destructive has-[:disabled]:opacity-50"
+	// Inputs fill the remaining space and hand their chrome to the group
+	inputs := " [&>input]:h-full [&>input]:min-w-0 [&>input]:flex-1 [&>input]:rounded-none [&>input]:border-0 [&>input]:bg-transparent dark:[&>input]:bg-transparent [&>input]:shadow-none [&>input]:focus-visible:ring-0"
+	// Selects and buttons keep their size and get a separator toward the input
+	controls := " [&>select]:h-full [&>select]:w-auto [&>select]:rounded-none [&>select]:border-0 [&>select]:bg-transparent [&>select]:shadow-none [&>select]:focus-visible:ring-0 [&>select:first-child]:border-r [&>select:last-child]:border-l" +
+		" [&>button]:h-full [&>button]:rounded-none [&>button]:shadow-none [&>button]:focus-visible:ring-0 [&>button]:focus-visible:bg-accent [&>button:first-child]:border-r [&>button:last-child]:border-l [&>button]:border-input"
+
+	groupArgs := []x.DivArg{
+		x.Class(group + inputs + controls),
+		x.Role("group"),
+		x.Data("slot", "input-group"),
+	}
+	groupArgs = append(groupArgs, args...)
+
+	return x.Div(groupArgs...)
+}
@@ -0,0 +1,228 @@
+package ui
+
+import (
+	"strconv"
+
+	x "github.com/plainkit/html"
+)
+
+const inputOTPJS = `(function(){
+  const slot = el=>el && el.closest ? el.closest('[data-otp-slot]') : null;
+  const slots = root=>Array.from(root.querySelectorAll('[data-otp-slot]'));
+
+  function sync(root){
+    const hidden = root.querySelector('input[type="hidden"]');
+    if(hidden) hidden.value = slots(root).map(s=>s.value).join('');
+    root.dispatchEvent(new Event('change', {bubbles:true}));
+  }
+
+  function focusSlot(s){ if(s){ s.focus(); s.select(); } }
+
+  // spreads chars over the slots starting at index i, skipping chars the pattern rejects
+  function fill(root, i, text){
+    const all = slots(root), re = new RegExp('^'+root.dataset.pattern+'$');
+    for(const ch of text){
+      if(i>=all.length) break;
+      if(!re.test(ch)) continue;
+      all[i++].value = root.dataset.uppercase ? ch.toUpperCase() : ch;
+    }
+    sync(root);
+    focusSlot(all[Math.min(i, all.length-1)]);
+  }
+
+  document.addEventListener('input', e=>{
+    const s = slot(e.target);
+    if(!s) return;
+    const root = s.closest('[data-slot="input-otp"]'), all = slots(root);
+    const text = s.value;
+    s.value = '';
+    // autofill of one-time-code and fast typing can land several chars in one slot
+    fill(root, all.indexOf(s), text.length>1 && all.indexOf(s)===0 ? text : text.slice(-1));
+  });
+
+  document.addEventListener('paste', e=>{
+    const s = slot(e.target);
+    if(!s) return;
+    e.preventDefault();
+    const root = s.closest('[data-slot="input-otp"]');
+    fill(root, slots(root).indexOf(s), (e.clipboardData||window.clipboardData).getData('text').trim());
+  });
+
+  document.addEventListener('keydown', e=>{
+    const s = slot(e.target);
+    if(!s) return;
+    const root = s.closest('[data-slot="input-otp"]'), all = slots(root), i = all.indexOf(s);
+    if(e.key==='Backspace'){
+      e.preventDefault();
+      if(s.value===''){ focusSlot(all[i-1]); if(all[i-1]) all[i-1].value = ''; }
+      else s.value = '';
+      sync(root);
+    }else if(e.key==='ArrowLeft'){ e.preventDefault(); focusSlot(all[i-1]); }
+    else if(e.key==='ArrowRight'){ e.preventDefault(); focusSlot(all[i+1]); }
+  });
+
+  document.addEventListener('focusin', e=>{ const s = slot(e.target); if(s) s.select(); });
+})();`
+
+const (
+	otpNumericPattern      = "[0-9]"
+	otpAlphanumericPattern = "[A-Za-z0-9]"
+)
+
+type inputOTPOpts struct {
+	value     string
+	pattern   string
+	groupSize int
+	uppercase bool
+	required  bool
+	disabled  bool
+}
+
+// Internal wrapper so InputOTP can pick its own options out of x.DivArg
+type inputOTPArg struct {
+	x.Global
+	apply func(*inputOTPOpts)
+}
+
+func newInputOTPArg(apply func(*inputOTPOpts)) x.DivArg {
+	return inputOTPArg{Global: x.Class(""), apply: apply}
+}
+
+// InputOTPValue pre-fills the slots
+func InputOTPValue(v string) x.DivArg {
+	return newInputOTPArg(func(o *inputOTPOpts) { o.value = v })
+}
+
+// InputOTPAlphanumeric accepts letters and digits (digits only by default); letters are upper-cased
+func InputOTPAlphanumeric() x.DivArg {
+	return newInputOTPArg(func(o *inputOTPOpts) {
+		o.pattern = otpAlphanumericPattern
+		o.uppercase = true
+	})
+}
+
+// InputOTPPattern sets a custom single-character pattern, e.g. "[0-9A-F]"
+func InputOTPPattern(charPattern string) x.DivArg {
+	return newInputOTPArg(func(o *inputOTPOpts) { o.pattern = charPattern })
+}
+
+// InputOTPGroupSize inserts a separator after every n slots (e.g. 3 renders 123–456)
+func InputOTPGroupSize(n int) x.DivArg {
+	return newInputOTPArg(func(o *inputOTPOpts) { o.groupSize = n })
+}
+
+// InputOTPRequired makes every slot required so the form won't submit a partial code
+func InputOTPRequired() x.DivArg {
+	return newInputOTPArg(func(o *inputOTPOpts) { o.required = true })
+}
+
+// InputOTPDisabled disables every slot
+func InputOTPDisabled() x.DivArg {
+	return newInputOTPArg(func(o *inputOTPOpts) { o.disabled = true })
+}
+
+// InputOTP renders length single-character slots for one-time codes and PINs with
+// auto-advance, paste-to-fill and backspace navigation. The slots themselves are unnamed;
+// the joined code is submitted as a single hidden field called name.
+func InputOTP(name string, length int, args ...x.DivArg) x.Node {
+	o := inputOTPOpts{pattern: otpNumericPattern}
+	var rootArgs []x.DivArg
+	for _, a := range args {
+		if t, ok := a.(inputOTPArg); ok {
+			t.apply(&o)
+			continue
+		}
+		rootArgs = append(rootArgs, a)
+	}
+
+	chars := []rune(o.value)
+	otpArgs := []x.DivArg{
+		x.Class("flex items-center gap-2 has-[:disabled]:opacity-50"),
+		x.Role("group"),
+		x.Data("slot", "input-otp"),
+		x.Data("pattern", o.pattern),
+	}
+	if o.uppercase {
+		otpArgs = append(otpArgs, x.Data("uppercase", "true"))
+	}
+
+	hiddenArgs := []x.InputArg{x.InputType("hidden"), x.InputName(name)}
+	if len(chars) > length {
+		chars = chars[:length]
+	}
+	if len(chars) > 0 {
+		hiddenArgs = append(hiddenArgs, x.InputValue(string(chars)))
+	}
+	// the hidden input carries the code, so it must not post while the slots are disabled
+	if o.disabled {
+		hiddenArgs = append(hiddenArgs, x.Disabled())
+	}
+	otpArgs = append(otpArgs, x.Child(x.Input(hiddenArgs...)))
+
+	var group []x.DivArg
+	flush := func() {
+		if len(group) == 0 {
+			return
+		}
+		otpArgs = append(otpArgs, x.Child(x.Div(append([]x.DivArg{x.Class("flex items-center -space-x-px")}, group...)...)))
+		group = nil
+	}
+	for i := 0; i < length; i++ {
+		if o.groupSize > 0 && i > 0 && i%o.groupSize == 0 {
+			flush()
+			otpArgs = append(otpArgs, x.Child(inputOTPSeparator()))
+		}
+		var ch string
+		if i < len(chars) {
+			ch = string(chars[i])
+		}
+		group = append(group, x.Child(o.slot(i, length, ch)))
+	}
+	flush()
+	otpArgs = append(otpArgs, rootArgs...)
+
+	return x.Div(otpArgs...).WithAssets("", inputOTPJS, "input-otp")
+}
+
+func (o inputOTPOpts) slot(i, length int, ch string) x.Node {
+	classes := "relative size-9 border border-input bg-background dark:bg-input/30 text-center text-sm font-medium shadow-xs outline-none transition-[color,box-shadow] first:rounded-l-md last:rounded-r-md focus:z-10 focus:border-ring focus:ring-[3px] focus:ring-ring/50 aria-invalid:border-destructive disabled:cursor-not-allowed"
+	if o.uppercase {
+		classes += " uppercase"
+	}
+	slotArgs := []x.InputArg{
+		x.Class(classes),
+		x.InputType("text"),
+		x.Pattern(o.pattern),
+		x.Aria("label", "Character "+strconv.Itoa(i+1)+" of "+strconv.Itoa(length)),
+		x.Data("otp-slot", strconv.Itoa(i)),
+	}
+	if o.pattern == otpNumericPattern {
+		slotArgs = append(slotArgs, x.InputMode("numeric"))
+	}
+	// The first slot receives the browser's SMS/one-time-code autofill, which maxlength would
+	// truncate; the script spreads it over the other slots
+	if i == 0 {
+		slotArgs = append(slotArgs, x.Custom("autocomplete", "one-time-code"))
+	} else {
+		slotArgs = append(slotArgs, x.Custom("autocomplete", "off"), x.Maxlength(1))
+	}
+	if ch != "" {
+		slotArgs = append(slotArgs, x.InputValue(ch))
+	}
+	if o.required {
+		slotArgs = append(slotArgs, x.Required())
+	}
+	if o.disabled {
+		slotArgs = append(slotArgs, x.Disabled())
+	}
+	return x.Input(slotArgs...)
+}
+
+func inputOTPSeparator() x.Node {
+	return x.Div(
+		x.Class("text-muted-foreground"),
+		x.Role("separator"),
+		x.Data("slot", "input-otp-separator"),
+		x.T("–"),
+	)
+}
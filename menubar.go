@@ -0,0 +1,293 @@
+package ui
+
+import (
+	x "github.com/plainkit/html"
+	"github.com/plainkit/icons/lucide"
+)
+
+const menubarJS = `(function(){
+  function init(bar){
+    if(bar.hasAttribute('data-js')) return;
+    bar.setAttribute('data-js', '');
+    const triggers = Array.from(bar.querySelectorAll('[data-slot="menubar-trigger"]'));
+    if(!triggers.length) return;
+
+    const contentFor = t=>document.getElementById(t.getAttribute('aria-controls')||'');
+    const triggerFor = c=>bar.querySelector('[aria-controls="'+c.id+'"]');
+    const current = ()=>triggers.find(t=>t.getAttribute('aria-expanded')==='true');
+    const items = c=>Array.from(c.querySelectorAll('[role^="menuitem"]:not([disabled])'));
+    const step = (t, dir)=>triggers[(triggers.indexOf(t)+dir+triggers.length)%triggers.length];
+
+    // roving tabindex: only one top-level menu is in the tab order
+    function roving(t){ triggers.forEach(o=>o.tabIndex = o===t? 0 : -1); }
+    roving(triggers[0]);
+
+    function hide(t){
+      t.setAttribute('aria-expanded', 'false');
+      t.dataset.state = 'closed';
+      const c = contentFor(t);
+      if(c) c.dataset.state = 'closed';
+    }
+    // focus: 'first', 'last' or null to leave focus on the trigger
+    function open(t, focus){
+      const prev = current();
+      if(prev && prev!==t) hide(prev);
+      const c = contentFor(t);
+      if(!c) return;
+      t.setAttribute('aria-expanded', 'true');
+      t.dataset.state = 'open';
+      c.dataset.state = 'open';
+      roving(t);
+      const list = items(c);
+      if(!list.length || !focus) t.focus();
+      else list[focus==='last'? list.length-1 : 0].focus();
+    }
+    function close(refocus){
+      const t = current();
+      if(!t) return;
+      hide(t);
+      if(refocus) t.focus();
+    }
+
+    function select(item){
+      const role = item.getAttribute('role');
+      if(role==='menuitemcheckbox'){
+        item.setAttribute('aria-checked', item.getAttribute('aria-checked')==='true'? 'false':'true');
+      } else if(role==='menuitemradio'){
+        const group = item.closest('[role="group"]') || item.parentElement;
+        group.querySelectorAll('[role="menuitemradio"]').forEach(r=>r.setAttribute('aria-checked', r===item? 'true':'false'));
+      }
+      item.dispatchEvent(new CustomEvent('menubar:select', {bubbles: true, detail: {
+        value: item.dataset.value||'',
+        checked: item.getAttribute('aria-checked')==='true'
+      }}));
+      close(true);
+    }
+
+    bar.addEventListener('click', e=>{
+      const t = e.target.closest('[data-slot="menubar-trigger"]');
+      if(t){
+        if(t.getAttribute('aria-expanded')==='true') close(false);
+        else open(t, null);
+        return;
+      }
+      const item = e.target.closest('[role^="menuitem"]');
+      if(item && !item.disabled) select(item);
+    });
+
+    // once a menu is open, hovering another trigger switches to it; hovering items focuses them
+    triggers.forEach(t=>t.addEventListener('pointerenter', ()=>{
+      const c = current();
+      if(c && c!==t) open(t, null);
+    }));
+    bar.addEventListener('pointerover', e=>{
+      const item = e.target.closest('[data-slot="menubar-content"] [role^="menuitem"]');
+      if(item && !item.disabled) item.focus({preventScroll: true});
+    });
+
+    bar.addEventListener('keydown', e=>{
+      const t = e.target.closest('[data-slot="menubar-trigger"]');
+      if(t){
+        switch(e.key){
+          case 'ArrowRight':
+          case 'ArrowLeft': {
+            e.preventDefault();
+            const next = step(t, e.key==='ArrowRight'? 1 : -1);
+            if(current()) open(next, null);
+            roving(next);
+            next.focus();
+            break;
+          }
+          case 'Home': case 'End': {
+            e.preventDefault();
+            const next = triggers[e.key==='Home'? 0 : triggers.length-1];
+            roving(next);
+            next.focus();
+            break;
+          }
+          case 'ArrowDown': case 'Enter': case ' ':
+            e.preventDefault(); open(t, 'first'); break;
+          case 'ArrowUp':
+            e.preventDefault(); open(t, 'last'); break;
+          case 'Escape':
+            close(true); break;
+        }
+        return;
+      }
+
+      const content = e.target.closest('[data-slot="menubar-content"]');
+      if(!content) return;
+      const list = items(content);
+      const i = list.indexOf(document.activeElement);
+      switch(e.key){
+        case 'ArrowDown': e.preventDefault(); list[(i+1)%list.length].focus(); break;
+        case 'ArrowUp': e.preventDefault(); list[(i-1+list.length)%list.length].focus(); break;
+        case 'Home': e.preventDefault(); list[0].focus(); break;
+        case 'End': e.preventDefault(); list[list.length-1].focus(); break;
+        case 'ArrowRight': case 'ArrowLeft':
+          e.preventDefault();
+          open(step(triggerFor(content), e.key==='ArrowRight'? 1 : -1), 'first');
+          break;
+        case 'Escape': e.preventDefault(); close(true); break;
+        case 'Tab': close(false); break;
+        default:
+          // typeahead: jump to the next item starting with the typed letter
+          if(e.key.length===1 && !e.ctrlKey && !e.metaKey && !e.altKey){
+            const k = e.key.toLowerCase();
+            const order = list.slice(i+1).concat(list.slice(0, i+1));
+            const match = order.find(it=>it.textContent.trim().toLowerCase().startsWith(k));
+            if(match) match.focus();
+          }
+      }
+    });
+
+    document.addEventListener('pointerdown', e=>{ if(!bar.contains(e.target)) close(false); });
+    bar.addEventListener('focusout', e=>{ if(e.relatedTarget && !bar.contains(e.relatedTarget)) close(false); });
+  }
+
+  function initAll(){ document.querySelectorAll('[data-slot="menubar"]').forEach(init); }
+  if(document.readyState==='loading'){
+    document.addEventListener('DOMContentLoaded', initAll);
+  } else {
+    initAll();
+  }
+})();`
+
+const menubarItemBase = "group/menubar-item relative flex w-full cursor-default select-none items-center gap-2 rounded-sm px-2 py-1.5 text-left text-sm outline-none focus:bg-accent focus:text-accent-foreground disabled:pointer-events-none disabled:opacity-50 [&_svg]:pointer-events-none [&_svg]:size-4 [&_svg]:shrink-0"
+
+// Menubar renders a desktop-style bar of menus (File, Edit, View) with role="menubar".
+// Pass MenubarMenus via x.Child. Items fire a bubbling "menubar:select" event whose detail
+// holds the item's data-value and checked state.
+func Menubar(args ...x.DivArg) x.Node {
+	barArgs := []x.DivArg{
+		x.Class("flex h-9 items-center gap-1 rounded-md border bg-background p-1 shadow-xs"),
+		x.Role("menubar"),
+		x.Data("slot", "menubar"),
+	}
+	barArgs = append(barArgs, args...)
+	return x.Div(barArgs...).WithAssets("", menubarJS, "menubar")
+}
+
+// MenubarMenu wraps a MenubarTrigger and its MenubarContent
+func MenubarMenu(args ...x.DivArg) x.Node {
+	menuArgs := append([]x.DivArg{x.Class("relative"), x.Data("slot", "menubar-menu")}, args...)
+	return x.Div(menuArgs...)
+}
+
+// MenubarTrigger renders the top-level button that opens the menu with id contentID
+func MenubarTrigger(contentID string, args ...x.ButtonArg) x.Node {
+	triggerArgs := []x.ButtonArg{
+		x.Class("flex select-none items-center rounded-sm px-2 py-1 text-sm font-medium outline-none focus:bg-accent focus:text-accent-foreground data-[state=open]:bg-accent data-[state=open]:text-accent-foreground"),
+		x.ButtonType("button"),
+		x.Role("menuitem"),
+		x.Aria("haspopup", "menu"),
+		x.Aria("expanded", "false"),
+		x.Aria("controls", contentID),
+		x.Data("slot", "menubar-trigger"),
+		x.Data("state", "closed"),
+	}
+	triggerArgs = append(triggerArgs, args...)
+	return x.Button(triggerArgs...)
+}
+
+// MenubarContent renders the dropdown menu; pass items, labels and separators via x.Child
+func MenubarContent(id string, args ...x.DivArg) x.Node {
+	contentArgs := []x.DivArg{
+		x.Class("absolute top-full left-0 z-50 mt-2 hidden min-w-48 rounded-md border bg-popover p-1 text-popover-foreground shadow-md data-[state=open]:block"),
+		x.Id(id),
+		x.Role("menu"),
+		x.Data("slot", "menubar-content"),
+		x.Data("state", "closed"),
+	}
+	contentArgs = append(contentArgs, args...)
+	return x.Div(contentArgs...)
+}
+
+// MenubarItem renders a command. Give it x.Data("value", ...) to identify it in the select event,
+// or make it submit a form with x.ButtonType("submit").
+func MenubarItem(args ...x.ButtonArg) x.Node {
+	itemArgs := []x.ButtonArg{
+		x.Class(menubarItemBase),
+		x.ButtonType("button"),
+		x.Role("menuitem"),
+		x.TabIndex(-1),
+		x.Data("slot", "menubar-item"),
+	}
+	itemArgs = append(itemArgs, args...)
+	return x.Button(itemArgs...)
+}
+
+// MenubarShortcut shows an item's keyboard shortcut, e.g. x.T("⌘S"). It is display only;
+// also pass x.Aria("keyshortcuts", "Meta+S") on the item for assistive tech.
+func MenubarShortcut(args ...x.SpanArg) x.Node {
+	shortcutArgs := []x.SpanArg{
+		x.Class("ml-auto pl-4 text-xs tracking-widest text-muted-foreground group-focus/menubar-item:text-accent-foreground"),
+		x.Aria("hidden", "true"),
+		x.Data("slot", "menubar-shortcut"),
+	}
+	shortcutArgs = append(shortcutArgs, args...)
+	return x.Span(shortcutArgs...)
+}
+
+// MenubarChecked renders a checkbox or radio item checked
+func MenubarChecked() x.ButtonArg {
+	return x.Aria("checked", "true")
+}
+
+// MenubarCheckboxItem renders an item that toggles on and off with a check mark
+func MenubarCheckboxItem(args ...x.ButtonArg) x.Node {
+	itemArgs := []x.ButtonArg{
+		x.Class(menubarItemBase + " pl-8"),
+		x.ButtonType("button"),
+		x.Role("menuitemcheckbox"),
+		x.Aria("checked", "false"),
+		x.TabIndex(-1),
+		x.Data("slot", "menubar-checkbox-item"),
+		x.Child(menubarIndicator(lucide.Check())),
+	}
+	itemArgs = append(itemArgs, args...)
+	return x.Button(itemArgs...)
+}
+
+// MenubarRadioGroup groups MenubarRadioItems so that checking one unchecks the others
+func MenubarRadioGroup(args ...x.DivArg) x.Node {
+	groupArgs := append([]x.DivArg{x.Role("group"), x.Data("slot", "menubar-radio-group")}, args...)
+	return x.Div(groupArgs...)
+}
+
+// MenubarRadioItem renders one choice of a MenubarRadioGroup; value is sent in the select event
+func MenubarRadioItem(value string, args ...x.ButtonArg) x.Node {
+	itemArgs := []x.ButtonArg{
+		x.Class(menubarItemBase + " pl-8"),
+		x.ButtonType("button"),
+		x.Role("menuitemradio"),
+		x.Aria("checked", "false"),
+		x.TabIndex(-1),
+		x.Data("slot", "menubar-radio-item"),
+		x.Data("value", value),
+		x.Child(menubarIndicator(lucide.Circle(x.Class("fill-current"), lucide.Size("8")))),
+	}
+	itemArgs = append(itemArgs, args...)
+	return x.Button(itemArgs...)
+}
+
+// menubarIndicator shows icon at the start of a checkbox or radio item while it is checked
+func menubarIndicator(icon x.Node) x.Node {
+	return x.Span(
+		x.Class("pointer-events-none absolute left-2 flex size-3.5 items-center justify-center opacity-0 group-aria-checked/menubar-item:opacity-100"),
+		x.Aria("hidden", "true"),
+		x.Child(icon),
+	)
+}
+
+// MenubarLabel renders a non-interactive heading inside a menu
+func MenubarLabel(args ...x.DivArg) x.Node {
+	labelArgs := append([]x.DivArg{x.Class("px-2 py-1.5 text-sm font-medium"), x.Data("slot", "menubar-label")}, args...)
+	return x.Div(labelArgs...)
+}
+
+// MenubarSeparator renders a divider between groups of items
+func MenubarSeparator(args ...x.DivArg) x.Node {
+	sepArgs := append([]x.DivArg{x.Class("-mx-1 my-1 h-px bg-border"), x.Role("separator"), x.Data("slot", "menubar-separator")}, args...)
+	return x.Div(sepArgs...)
+}
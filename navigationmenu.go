@@ -0,0 +1,290 @@
+package ui
+
+import (
+	x "github.com/plainkit/html"
+	"github.com/plainkit/icons/lucide"
+)
+
+// Without JS, panels open on hover/focus and the mobile list opens while the nav has focus
+const navigationMenuCSS = `
+[data-slot=navigation-menu-content] { display: none; }
+
+[data-slot=navigation-menu]:not([data-js]) [data-slot=navigation-menu-item]:is(:hover, :focus-within) > [data-slot=navigation-menu-content],
+[data-slot=navigation-menu-content][data-state=open] {
+    display: block;
+}
+
+@media (max-width: 767px) {
+    [data-slot=navigation-menu]:not([data-js]):focus-within [data-slot=navigation-menu-list] { display: flex; }
+}
+
+/* with JS the shared viewport draws the panel's box and animates between panels */
+@media (min-width: 768px) {
+    [data-slot=navigation-menu][data-js] [data-slot=navigation-menu-content] {
+        background: transparent;
+        border-color: transparent;
+        box-shadow: none;
+    }
+}`
+
+const navigationMenuJS = `(function(){
+  const OPEN_DELAY = 150, CLOSE_DELAY = 300;
+  const desktop = window.matchMedia('(min-width: 768px)');
+
+  function init(nav){
+    if(nav.hasAttribute('data-js')) return;
+    nav.setAttribute('data-js', '');
+    const viewport = nav.querySelector('[data-slot="navigation-menu-viewport"]');
+    const toggle = nav.querySelector('[data-slot="navigation-menu-mobile-toggle"]');
+    let openTimer, closeTimer, hoverOpenedAt = 0;
+
+    const triggers = ()=>Array.from(nav.querySelectorAll('[data-slot="navigation-menu-trigger"]'));
+    const current = ()=>triggers().find(t=>t.getAttribute('aria-expanded')==='true');
+    const contentFor = t=>document.getElementById(t.getAttribute('aria-controls')||'');
+    const triggerFor = c=>nav.querySelector('[aria-controls="'+c.id+'"]');
+
+    // size the viewport to the panel, animating only when moving between open panels
+    function place(content){
+      if(!desktop.matches || !viewport) return;
+      const item = content.closest('[data-slot="navigation-menu-item"]');
+      const navRect = nav.getBoundingClientRect();
+      let left = item.getBoundingClientRect().left - navRect.left;
+      left = Math.max(0, Math.min(left, navRect.width - content.offsetWidth));
+      content.style.left = left+'px';
+      const jump = viewport.dataset.state!=='open';
+      if(jump) viewport.style.transition = 'none';
+      viewport.style.left = left+'px';
+      viewport.style.width = content.offsetWidth+'px';
+      viewport.style.height = content.offsetHeight+'px';
+      viewport.dataset.state = 'open';
+      if(jump){ viewport.offsetWidth; viewport.style.transition = ''; }
+    }
+
+    function hide(t){
+      t.setAttribute('aria-expanded', 'false');
+      t.dataset.state = 'closed';
+      const content = contentFor(t);
+      if(content) content.dataset.state = 'closed';
+    }
+    function open(t){
+      const prev = current();
+      if(prev && prev!==t) hide(prev);
+      const content = contentFor(t);
+      if(!content) return;
+      t.setAttribute('aria-expanded', 'true');
+      t.dataset.state = 'open';
+      content.dataset.state = 'open';
+      place(content);
+    }
+    function close(){
+      clearTimeout(openTimer);
+      const t = current();
+      if(t) hide(t);
+      if(viewport) viewport.dataset.state = 'closed';
+    }
+    function setMobile(open){
+      nav.dataset.mobile = open? 'open':'closed';
+      if(toggle) toggle.setAttribute('aria-expanded', open? 'true':'false');
+    }
+
+    // hover intent: wait before opening, switch immediately once a panel is open, linger on leave
+    nav.querySelectorAll('[data-slot="navigation-menu-item"]').forEach(item=>{
+      const t = item.querySelector('[data-slot="navigation-menu-trigger"]');
+      item.addEventListener('pointerenter', e=>{
+        if(e.pointerType!=='mouse' || !desktop.matches) return;
+        clearTimeout(closeTimer);
+        clearTimeout(openTimer);
+        if(!t){ if(current()) openTimer = setTimeout(close, OPEN_DELAY); return; }
+        if(t.getAttribute('aria-expanded')==='true') return;
+        openTimer = setTimeout(()=>{ open(t); hoverOpenedAt = Date.now(); }, current()? 0 : OPEN_DELAY);
+      });
+      item.addEventListener('pointerleave', e=>{
+        if(e.pointerType!=='mouse' || !desktop.matches) return;
+        clearTimeout(openTimer);
+        closeTimer = setTimeout(close, CLOSE_DELAY);
+      });
+    });
+
+    nav.addEventListener('click', e=>{
+      const t = e.target.closest('[data-slot="navigation-menu-trigger"]');
+      if(t){
+        clearTimeout(openTimer);
+        if(t.getAttribute('aria-expanded')!=='true') open(t);
+        // a click right after hover-open confirms it rather than closing
+        else if(Date.now()-hoverOpenedAt > 500) close();
+        return;
+      }
+      if(e.target.closest('[data-slot="navigation-menu-mobile-toggle"]')) setMobile(nav.dataset.mobile!=='open');
+    });
+
+    nav.addEventListener('keydown', e=>{
+      const top = Array.from(nav.querySelectorAll('[data-slot="navigation-menu-item"] > :is([data-slot="navigation-menu-trigger"],[data-slot="navigation-menu-link"])'));
+      const i = top.indexOf(document.activeElement);
+      if(i>=0 && (e.key==='ArrowRight' || e.key==='ArrowLeft')){
+        e.preventDefault();
+        top[(i + (e.key==='ArrowRight'? 1 : -1) + top.length) % top.length].focus();
+        return;
+      }
+      if(i>=0 && e.key==='ArrowDown' && top[i].dataset.slot==='navigation-menu-trigger'){
+        e.preventDefault();
+        open(top[i]);
+        const first = contentFor(top[i]) && contentFor(top[i]).querySelector('a[href]');
+        if(first) first.focus();
+        return;
+      }
+      const content = document.activeElement.closest('[data-slot="navigation-menu-content"]');
+      if(content && (e.key==='ArrowDown' || e.key==='ArrowUp')){
+        e.preventDefault();
+        const links = Array.from(content.querySelectorAll('a[href]'));
+        const next = links.indexOf(document.activeElement) + (e.key==='ArrowDown'? 1 : -1);
+        if(next < 0) triggerFor(content).focus();
+        else links[Math.min(next, links.length-1)].focus();
+        return;
+      }
+      if(e.key==='Escape'){
+        const t = current();
+        if(t){ close(); t.focus(); }
+        else if(nav.dataset.mobile==='open'){ setMobile(false); if(toggle) toggle.focus(); }
+      }
+    });
+
+    nav.addEventListener('focusout', e=>{
+      if(!nav.contains(e.relatedTarget)){ close(); setMobile(false); }
+    });
+    window.addEventListener('resize', ()=>{ const t = current(); if(t) place(contentFor(t)); });
+    desktop.addEventListener('change', ()=>{ close(); setMobile(false); });
+  }
+
+  function initAll(){ document.querySelectorAll('[data-slot="navigation-menu"]').forEach(init); }
+  if(document.readyState==='loading'){
+    document.addEventListener('DOMContentLoaded', initAll);
+  } else {
+    initAll();
+  }
+})();`
+
+const navigationMenuTriggerBase = "inline-flex h-9 w-max items-center justify-center gap-1 rounded-md bg-background px-4 py-2 text-sm font-medium outline-none transition-colors hover:bg-accent hover:text-accent-foreground focus-visible:ring-[3px] focus-visible:ring-ring/50 disabled:pointer-events-none disabled:opacity-50 data-[state=open]:bg-accent/50 max-md:w-full max-md:justify-between"
+
+// NavigationMenu renders a horizontal site navigation whose triggers open dropdown panels.
+// Below the md breakpoint the list collapses behind a menu button and panels open inline.
+// Pass the NavigationMenuList with id listID via x.Child; name it with x.Aria("label", ...)
+// (default "Main").
+func NavigationMenu(listID string, args ...x.NavArg) x.Node {
+	navArgs := []x.NavArg{
+		x.Class("group/navigation-menu relative z-40 flex items-center"),
+		x.Aria("label", "Main"),
+		x.Data("slot", "navigation-menu"),
+		x.Data("mobile", "closed"),
+		x.Child(x.Button(
+			ButtonClass(ButtonGhost(), ButtonIcon()),
+			x.Class("md:hidden"),
+			x.ButtonType("button"),
+			x.Aria("label", "Menu"),
+			x.Aria("expanded", "false"),
+			x.Aria("controls", listID),
+			x.Data("slot", "navigation-menu-mobile-toggle"),
+			x.Child(lucide.Menu()),
+		)),
+	}
+	navArgs = append(navArgs, args...)
+	navArgs = append(navArgs, x.Child(x.Div(
+		x.Class("absolute top-full z-0 mt-1.5 hidden rounded-md border bg-popover shadow-md transition-[left,width,height] duration-200 ease-out md:data-[state=open]:block"),
+		x.Aria("hidden", "true"),
+		x.Data("slot", "navigation-menu-viewport"),
+		x.Data("state", "closed"),
+	)))
+
+	return x.Nav(navArgs...).WithAssets(navigationMenuCSS, navigationMenuJS, "navigation-menu")
+}
+
+// NavigationMenuList renders the row of NavigationMenuItems; id is the listID given to NavigationMenu
+func NavigationMenuList(id string, args ...x.UlArg) x.Node {
+	classes := "flex list-none items-center gap-1 max-md:absolute max-md:inset-x-0 max-md:top-full max-md:mt-1.5 max-md:hidden max-md:flex-col max-md:items-stretch max-md:rounded-md max-md:border max-md:bg-popover max-md:p-2 max-md:shadow-md max-md:group-data-[mobile=open]/navigation-menu:flex"
+	listArgs := []x.UlArg{
+		x.Class(classes),
+		x.Id(id),
+		x.Data("slot", "navigation-menu-list"),
+	}
+	listArgs = append(listArgs, args...)
+	return x.Ul(listArgs...)
+}
+
+// NavigationMenuItem wraps a NavigationMenuTrigger and its NavigationMenuContent, or a NavigationMenuLink
+func NavigationMenuItem(args ...x.LiArg) x.Node {
+	itemArgs := append([]x.LiArg{x.Data("slot", "navigation-menu-item")}, args...)
+	return x.Li(itemArgs...)
+}
+
+// NavigationMenuTrigger renders the button that opens the panel with id contentID
+func NavigationMenuTrigger(contentID string, args ...x.ButtonArg) x.Node {
+	triggerArgs := []x.ButtonArg{
+		x.Class("group/navigation-menu-trigger " + navigationMenuTriggerBase),
+		x.ButtonType("button"),
+		x.Aria("expanded", "false"),
+		x.Aria("controls", contentID),
+		x.Data("slot", "navigation-menu-trigger"),
+		x.Data("state", "closed"),
+	}
+	triggerArgs = append(triggerArgs, args...)
+	triggerArgs = append(triggerArgs, x.Child(x.Span(
+		x.Class("transition-transform group-aria-expanded/navigation-menu-trigger:rotate-180 [&>svg]:size-3"),
+		x.Aria("hidden", "true"),
+		x.Child(lucide.ChevronDown()),
+	)))
+	return x.Button(triggerArgs...)
+}
+
+// NavigationMenuContent renders a dropdown panel; lay out its links with a child grid
+// (e.g. x.Ul(x.Class("grid gap-2 md:w-[500px] md:grid-cols-2"), ...))
+func NavigationMenuContent(id string, args ...x.DivArg) x.Node {
+	classes := "absolute top-full left-0 z-10 mt-1.5 w-max max-w-[calc(100vw-2rem)] rounded-md border bg-popover p-2 text-popover-foreground shadow-md transition-opacity starting:opacity-0 max-md:static max-md:mt-1 max-md:w-full max-md:max-w-none max-md:border-0 max-md:p-0 max-md:shadow-none"
+	contentArgs := []x.DivArg{
+		x.Class(classes),
+		x.Id(id),
+		x.Data("slot", "navigation-menu-content"),
+		x.Data("state", "closed"),
+	}
+	contentArgs = append(contentArgs, args...)
+	return x.Div(contentArgs...)
+}
+
+// NavigationMenuLink renders a top-level link styled like a trigger
+func NavigationMenuLink(href string, args ...x.AArg) x.Node {
+	linkArgs := []x.AArg{
+		x.Class(navigationMenuTriggerBase + " aria-[current=page]:bg-accent/50"),
+		x.Href(href),
+		x.Data("slot", "navigation-menu-link"),
+	}
+	linkArgs = append(linkArgs, args...)
+	return x.A(linkArgs...)
+}
+
+// NavigationMenuContentLink renders a panel link with a title and an optional one-line description
+func NavigationMenuContentLink(href, title, description string, args ...x.AArg) x.Node {
+	linkArgs := []x.AArg{
+		x.Class("flex select-none flex-col gap-1 rounded-sm p-3 text-sm leading-none outline-none transition-colors hover:bg-accent hover:text-accent-foreground focus-visible:bg-accent focus-visible:text-accent-foreground"),
+		x.Href(href),
+		x.Data("slot", "navigation-menu-content-link"),
+		x.Child(x.Div(x.Class("font-medium leading-none"), x.T(title))),
+	}
+	if description != "" {
+		linkArgs = append(linkArgs, x.Child(x.P(x.Class("line-clamp-2 leading-snug text-muted-foreground"), x.T(description))))
+	}
+	linkArgs = append(linkArgs, args...)
+	return x.A(linkArgs...)
+}
+
+// NavigationMenuFeatured renders a tall highlighted card for a panel's lead link; pass a logo via x.Child
+func NavigationMenuFeatured(href, title, description string, args ...x.AArg) x.Node {
+	featuredArgs := []x.AArg{
+		x.Class("flex h-full w-full select-none flex-col justify-end rounded-md bg-linear-to-b from-muted/50 to-muted p-6 outline-none focus-visible:ring-[3px] focus-visible:ring-ring/50"),
+		x.Href(href),
+		x.Data("slot", "navigation-menu-featured"),
+	}
+	featuredArgs = append(featuredArgs, args...)
+	featuredArgs = append(featuredArgs,
+		x.Child(x.Div(x.Class("mt-4 mb-2 text-lg font-medium"), x.T(title))),
+		x.Child(x.P(x.Class("text-sm leading-tight text-muted-foreground"), x.T(description))),
+	)
+	return x.A(featuredArgs...)
+}
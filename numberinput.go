@@ -0,0 +1,321 @@
+package ui
+
+import (
+	"strconv"
+
+	x "github.com/plainkit/html"
+	"github.com/plainkit/icons/lucide"
+)
+
+const numberInputJS = `(function(){
+  const decimals = s=>{ const i = String(s).indexOf('.'); return i<0 ? 0 : String(s).length-i-1; };
+
+  function init(root){
+    if(root.dataset.ready) return;
+    root.dataset.ready = 'true';
+    const display = root.querySelector('[data-number-display]');
+    const raw = root.querySelector('input[type="hidden"]');
+    const d = root.dataset;
+    const min = d.min!==undefined ? parseFloat(d.min) : -Infinity;
+    const max = d.max!==undefined ? parseFloat(d.max) : Infinity;
+    const step = parseFloat(d.step||'1');
+    const places = decimals(d.step||'1');
+    const opts = {};
+    if(d.currency){ opts.style = 'currency'; opts.currency = d.currency; }
+    else if(d.percent){ opts.style = 'percent'; opts.maximumFractionDigits = Math.max(0, places-2); }
+    else { opts.maximumFractionDigits = Math.max(places, 3); }
+    const fmt = new Intl.NumberFormat(d.locale||undefined, opts);
+    // values are rounded to what the display can show (percent shows fraction*100)
+    const precision = Math.max(places, fmt.resolvedOptions().maximumFractionDigits+(d.percent ? 2 : 0));
+    const decimalSep = (new Intl.NumberFormat(d.locale||undefined).formatToParts(1.1).find(p=>p.type==='decimal')||{}).value || '.';
+
+    // the visible field posts raw numbers without JS; once enhanced the hidden input takes over,
+    // and like any native control it isn't submitted while the field is disabled
+    raw.name = display.name;
+    display.removeAttribute('name');
+    raw.disabled = display.disabled;
+
+    function parse(text){
+      let s = '';
+      for(const ch of text){
+        if(/[0-9]/.test(ch)) s += ch;
+        else if(ch===decimalSep) s += '.';
+        else if(ch==='-' || ch==='−') s = s.startsWith('-') ? s : '-'+s;
+      }
+      if(s==='' || s==='-') return NaN;
+      const n = parseFloat(s);
+      return d.percent ? n/100 : n;
+    }
+
+    // change only fires when the submitted value actually changes
+    function set(n){
+      const prev = raw.value;
+      if(isNaN(n)){
+        raw.value = '';
+        display.value = '';
+        display.removeAttribute('aria-valuenow');
+      }else{
+        n = Math.min(max, Math.max(min, n));
+        n = parseFloat(n.toFixed(precision));
+        raw.value = String(n);
+        display.value = fmt.format(n);
+        display.setAttribute('aria-valuenow', String(n));
+      }
+      raw.disabled = display.disabled;
+      root.querySelector('[data-number-step="down"]').disabled = display.disabled || display.readOnly || (!isNaN(n) && n<=min);
+      root.querySelector('[data-number-step="up"]').disabled = display.disabled || display.readOnly || (!isNaN(n) && n>=max);
+      if(raw.value!==prev) raw.dispatchEvent(new Event('change', {bubbles:true}));
+    }
+
+    function bump(dir, cur){
+      if(display.disabled || display.readOnly) return;
+      if(cur===undefined) cur = parseFloat(raw.value);
+      set(isNaN(cur) ? (isFinite(min) ? min : 0) : cur+dir*step);
+    }
+
+    set(raw.value==='' ? NaN : parseFloat(raw.value));
+
+    // show the bare number while editing, the formatted one otherwise
+    display.addEventListener('focus', ()=>{
+      if(raw.value==='') return;
+      const n = parseFloat(raw.value);
+      display.value = String(d.percent ? parseFloat((n*100).toFixed(10)) : n).replace('.', decimalSep);
+      display.select();
+    });
+    display.addEventListener('blur', ()=>set(parse(display.value)));
+    display.addEventListener('keydown', e=>{
+      if(e.key==='ArrowUp' || e.key==='ArrowDown'){
+        e.preventDefault();
+        const typed = parse(display.value);
+        bump(e.key==='ArrowUp' ? 1 : -1, isNaN(typed) ? undefined : typed);
+        display.select();
+      }else if(e.key==='Enter'){
+        set(parse(display.value));
+      }
+    });
+
+    // press and hold repeats after a short delay, until release or the button disables at a bound.
+    // The pointer is captured so the release reaches the button wherever it happens.
+    root.querySelectorAll('[data-number-step]').forEach(btn=>{
+      const dir = btn.dataset.numberStep==='up' ? 1 : -1;
+      let timer = null;
+      const stop = ()=>{ clearTimeout(timer); clearInterval(timer); timer = null; };
+      btn.addEventListener('pointerdown', e=>{
+        if(e.button!==0) return;
+        e.preventDefault();
+        btn.setPointerCapture(e.pointerId);
+        bump(dir);
+        if(btn.disabled) return;
+        timer = setTimeout(()=>{
+          timer = setInterval(()=>{ bump(dir); if(btn.disabled) stop(); }, 60);
+        }, 400);
+      });
+      ['pointerup','pointerleave','pointercancel','lostpointercapture'].forEach(t=>btn.addEventListener(t, stop));
+      // keyboard activation of the button itself
+      btn.addEventListener('click', e=>{ if(e.detail===0) bump(dir); });
+    });
+  }
+
+  function initAll(){ document.querySelectorAll('[data-slot="number-input"]').forEach(init); }
+  if(document.readyState==='loading'){
+    document.addEventListener('DOMContentLoaded', initAll);
+  }else{
+    initAll();
+  }
+})();`
+
+type numberInputOpts struct {
+	value    *float64
+	min, max *float64
+	step     float64
+	currency string
+	percent  bool
+	locale   string
+	disabled bool
+	readonly bool
+	required bool
+	label    string
+}
+
+// Internal wrapper so NumberInput can pick its own options out of x.DivArg
+type numberInputArg struct {
+	x.Global
+	apply func(*numberInputOpts)
+}
+
+func newNumberInputArg(apply func(*numberInputOpts)) x.DivArg {
+	return numberInputArg{Global: x.Class(""), apply: apply}
+}
+
+// NumberInputValue sets the initial value
+func NumberInputValue(v float64) x.DivArg {
+	return newNumberInputArg(func(o *numberInputOpts) { o.value = &v })
+}
+
+// NumberInputBounds clamps the value to [min, max]
+func NumberInputBounds(min, max float64) x.DivArg {
+	return newNumberInputArg(func(o *numberInputOpts) {
+		o.min = &min
+		o.max = &max
+	})
+}
+
+// NumberInputMin clamps the value from below only
+func NumberInputMin(min float64) x.DivArg {
+	return newNumberInputArg(func(o *numberInputOpts) { o.min = &min })
+}
+
+// NumberInputMax clamps the value from above only
+func NumberInputMax(max float64) x.DivArg {
+	return newNumberInputArg(func(o *numberInputOpts) { o.max = &max })
+}
+
+// NumberInputStep sets the stepper increment (default 1); its decimals also set the rounding precision
+func NumberInputStep(step float64) x.DivArg {
+	return newNumberInputArg(func(o *numberInputOpts) { o.step = step })
+}
+
+// NumberInputCurrency displays the value as an ISO 4217 currency amount, e.g. "USD"
+func NumberInputCurrency(code string) x.DivArg {
+	return newNumberInputArg(func(o *numberInputOpts) { o.currency = code })
+}
+
+// NumberInputPercent displays the value as a percentage; the posted value stays a fraction (0.25 → 25%)
+func NumberInputPercent() x.DivArg {
+	return newNumberInputArg(func(o *numberInputOpts) { o.percent = true })
+}
+
+// NumberInputLocale sets the BCP 47 locale used for display and parsing (browser default otherwise)
+func NumberInputLocale(locale string) x.DivArg {
+	return newNumberInputArg(func(o *numberInputOpts) { o.locale = locale })
+}
+
+// NumberInputLabel sets the accessible name of the field
+func NumberInputLabel(label string) x.DivArg {
+	return newNumberInputArg(func(o *numberInputOpts) { o.label = label })
+}
+
+// NumberInputRequired marks the field as required
+func NumberInputRequired() x.DivArg {
+	return newNumberInputArg(func(o *numberInputOpts) { o.required = true })
+}
+
+// NumberInputReadonly prevents editing while still posting the value
+func NumberInputReadonly() x.DivArg {
+	return newNumberInputArg(func(o *numberInputOpts) { o.readonly = true })
+}
+
+// NumberInputDisabled disables the field and its steppers
+func NumberInputDisabled() x.DivArg {
+	return newNumberInputArg(func(o *numberInputOpts) { o.disabled = true })
+}
+
+// NumberInput renders a text field with decrement/increment buttons, min/max/step clamping,
+// arrow-key and press-and-hold stepping, and optional currency or percent display formatting.
+// The formatted text is display only: the raw number is always what gets posted as name.
+func NumberInput(name string, args ...x.DivArg) x.Node {
+	o := numberInputOpts{step: 1}
+	var rootArgs []x.DivArg
+	for _, a := range args {
+		if t, ok := a.(numberInputArg); ok {
+			t.apply(&o)
+			continue
+		}
+		rootArgs = append(rootArgs, a)
+	}
+
+	step := strconv.FormatFloat(o.step, 'f', -1, 64)
+	rootData := []x.DivArg{
+		x.Class("flex h-9 w-full items-center rounded-md border border-input bg-background dark:bg-input/30 shadow-xs transition-[color,box-shadow] focus-within:border-ring focus-within:ring-[3px] focus-within:ring-ring/50 has-[:disabled]:opacity-50 has-[[aria-invalid=true]]:border-destructive"),
+		x.Role("group"),
+		x.Data("slot", "number-input"),
+		x.Data("step", step),
+	}
+	if o.min != nil {
+		rootData = append(rootData, x.Data("min", strconv.FormatFloat(*o.min, 'f', -1, 64)))
+	}
+	if o.max != nil {
+		rootData = append(rootData, x.Data("max", strconv.FormatFloat(*o.max, 'f', -1, 64)))
+	}
+	if o.currency != "" {
+		rootData = append(rootData, x.Data("currency", o.currency))
+	}
+	if o.percent {
+		rootData = append(rootData, x.Data("percent", "true"))
+	}
+	if o.locale != "" {
+		rootData = append(rootData, x.Data("locale", o.locale))
+	}
+
+	var value string
+	if o.value != nil {
+		value = strconv.FormatFloat(*o.value, 'f', -1, 64)
+	}
+
+	// Without JS the visible field posts the raw number itself
+	displayArgs := []x.InputArg{
+		x.Class("h-full min-w-0 flex-1 bg-transparent px-3 text-center text-base tabular-nums outline-none placeholder:text-muted-foreground disabled:cursor-not-allowed md:text-sm"),
+		x.InputType("text"),
+		x.InputName(name),
+		x.InputMode("decimal"),
+		x.Custom("autocomplete", "off"),
+		x.Role("spinbutton"),
+		x.Data("number-display", ""),
+	}
+	if value != "" {
+		displayArgs = append(displayArgs, x.InputValue(value), x.Aria("valuenow", value))
+	}
+	if o.min != nil {
+		displayArgs = append(displayArgs, x.Aria("valuemin", strconv.FormatFloat(*o.min, 'f', -1, 64)))
+	}
+	if o.max != nil {
+		displayArgs = append(displayArgs, x.Aria("valuemax", strconv.FormatFloat(*o.max, 'f', -1, 64)))
+	}
+	if o.label != "" {
+		displayArgs = append(displayArgs, x.Aria("label", o.label))
+	}
+	if o.required {
+		displayArgs = append(displayArgs, x.Required())
+	}
+	if o.readonly {
+		displayArgs = append(displayArgs, x.Readonly())
+	}
+	if o.disabled {
+		displayArgs = append(displayArgs, x.Disabled())
+	}
+
+	hiddenArgs := []x.InputArg{x.InputType("hidden")}
+	if value != "" {
+		hiddenArgs = append(hiddenArgs, x.InputValue(value))
+	}
+	if o.disabled {
+		hiddenArgs = append(hiddenArgs, x.Disabled())
+	}
+
+	rootData = append(rootData,
+		x.Child(o.stepButton("down", "Decrease", lucide.Minus(lucide.Size("14")))),
+		x.Child(x.Input(displayArgs...)),
+		x.Child(x.Input(hiddenArgs...)),
+		x.Child(o.stepButton("up", "Increase", lucide.Plus(lucide.Size("14")))),
+	)
+	rootData = append(rootData, rootArgs...)
+
+	return x.Div(rootData...).WithAssets("", numberInputJS, "number-input")
+}
+
+func (o numberInputOpts) stepButton(dir, label string, icon x.Node) x.Component {
+	btnArgs := []x.ButtonArg{
+		ButtonGhost(),
+		ButtonIcon(),
+		x.Class("mx-1.5 touch-none select-none"),
+		x.ButtonType("button"),
+		x.Aria("label", label),
+		x.TabIndex(-1),
+		x.Data("number-step", dir),
+		x.Child(icon),
+	}
+	if o.disabled || o.readonly {
+		btnArgs = append(btnArgs, x.Disabled())
+	}
+	return Button(btnArgs...)
+}
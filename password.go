@@ -0,0 +1,214 @@
+package ui
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	x "github.com/plainkit/html"
+	"github.com/plainkit/icons/lucide"
+)
+
+const passwordJS = `(function(){
+  const levels = ['Weak','Fair','Good','Strong'];
+
+  function evaluate(root, input){
+    const rules = root.querySelectorAll('[data-password-rule]');
+    if(!rules.length) return;
+    let met = 0;
+    rules.forEach(r=>{
+      const ok = new RegExp(r.dataset.passwordRule, 'u').test(input.value);
+      if(ok){ met++; r.dataset.met = 'true'; } else delete r.dataset.met;
+    });
+    // empty shows no strength; otherwise the share of met rules maps onto the bars
+    const meter = root.querySelector('[data-password-meter]');
+    const level = input.value==='' ? 0 : Math.max(1, Math.round(met/rules.length*levels.length));
+    meter.dataset.level = String(level);
+    meter.querySelector('[data-password-strength]').textContent = level ? levels[level-1] : '';
+  }
+
+  document.addEventListener('click', e=>{
+    const btn = e.target.closest && e.target.closest('[data-password-toggle]');
+    if(!btn) return;
+    const input = btn.closest('[data-slot="password-input"]').querySelector('input');
+    const show = input.type==='password';
+    input.type = show ? 'text' : 'password';
+    btn.setAttribute('aria-pressed', String(show));
+    btn.setAttribute('aria-label', show ? 'Hide password' : 'Show password');
+  });
+
+  document.addEventListener('input', e=>{
+    const root = e.target.closest && e.target.closest('[data-slot="password-input"]');
+    if(root) evaluate(root, e.target);
+  });
+
+  ['keydown','keyup'].forEach(t=>document.addEventListener(t, e=>{
+    const root = e.target.closest && e.target.closest('[data-slot="password-input"]');
+    if(!root || !e.getModifierState) return;
+    root.querySelector('[data-password-capslock]').hidden = !e.getModifierState('CapsLock');
+  }));
+
+  document.addEventListener('focusout', e=>{
+    const root = e.target.closest && e.target.closest('[data-slot="password-input"]');
+    if(root) root.querySelector('[data-password-capslock]').hidden = true;
+  });
+})();`
+
+// PasswordRule is a single password requirement. Pattern must be valid both as a Go regexp and
+// as a JavaScript RegExp with the u flag, so the same rule drives the checklist and ValidatePassword.
+type PasswordRule struct {
+	Label   string
+	Pattern string
+}
+
+// PasswordMinLength requires at least n characters
+func PasswordMinLength(n int) PasswordRule {
+	return PasswordRule{Label: fmt.Sprintf("At least %d characters", n), Pattern: fmt.Sprintf(`^[\s\S]{%d,}$`, n)}
+}
+
+// PasswordUppercase requires an uppercase letter
+func PasswordUppercase() PasswordRule {
+	return PasswordRule{Label: "An uppercase letter", Pattern: `[A-Z]`}
+}
+
+// PasswordLowercase requires a lowercase letter
+func PasswordLowercase() PasswordRule {
+	return PasswordRule{Label: "A lowercase letter", Pattern: `[a-z]`}
+}
+
+// PasswordDigit requires a digit
+func PasswordDigit() PasswordRule {
+	return PasswordRule{Label: "A number", Pattern: `[0-9]`}
+}
+
+// PasswordSymbol requires a character that is neither a letter nor a digit
+func PasswordSymbol() PasswordRule {
+	return PasswordRule{Label: "A symbol", Pattern: `[^A-Za-z0-9]`}
+}
+
+// DefaultPasswordRules is used by PasswordStrength when no rules are given
+var DefaultPasswordRules = []PasswordRule{
+	PasswordMinLength(12),
+	PasswordUppercase(),
+	PasswordLowercase(),
+	PasswordDigit(),
+	PasswordSymbol(),
+}
+
+// PasswordRuleError lists the rules a password failed
+type PasswordRuleError struct {
+	Failed []PasswordRule
+}
+
+func (e *PasswordRuleError) Error() string {
+	labels := make([]string, len(e.Failed))
+	for i, r := range e.Failed {
+		labels[i] = strings.ToLower(r.Label)
+	}
+	return "Password needs " + strings.Join(labels, ", ")
+}
+
+// ValidatePassword checks password against rules on the server and returns a *PasswordRuleError
+// naming every failed rule, or nil. Its message is suitable for FieldErrors.
+func ValidatePassword(password string, rules []PasswordRule) error {
+	var failed []PasswordRule
+	for _, r := range rules {
+		re, err := regexp.Compile(r.Pattern)
+		if err != nil {
+			return fmt.Errorf("ui: invalid password rule %q: %w", r.Label, err)
+		}
+		if !re.MatchString(password) {
+			failed = append(failed, r)
+		}
+	}
+	if len(failed) > 0 {
+		return &PasswordRuleError{Failed: failed}
+	}
+	return nil
+}
+
+// Internal wrapper so PasswordInput can pick its rules out of x.InputArg
+type passwordStrengthArg struct {
+	x.Global
+	rules []PasswordRule
+}
+
+// PasswordStrength adds a strength meter and a live checklist of rules (DefaultPasswordRules when none are given).
+// Pass the same rules to ValidatePassword when handling the form.
+func PasswordStrength(rules ...PasswordRule) x.InputArg {
+	if len(rules) == 0 {
+		rules = DefaultPasswordRules
+	}
+	return passwordStrengthArg{Global: x.Class(""), rules: rules}
+}
+
+// PasswordInput renders Input as a password field with a show/hide toggle and a Caps Lock
+// warning. Pass input attributes via x.InputArg; PasswordStrength adds the meter and checklist.
+func PasswordInput(args ...x.InputArg) x.Node {
+	inputArgs := []x.InputArg{x.InputType("password"), x.Class("pr-10")}
+	var rules []PasswordRule
+	for _, a := range args {
+		if s, ok := a.(passwordStrengthArg); ok {
+			rules = s.rules
+			continue
+		}
+		inputArgs = append(inputArgs, a)
+	}
+
+	toggle := x.Button(
+		x.Class("absolute inset-y-0 right-0 flex w-9 items-center justify-center rounded-r-md text-muted-foreground hover:text-foreground focus-visible:outline-none focus-visible:ring-1 focus-visible:ring-ring [&[aria-pressed=true]>.lucide-eye]:hidden [&[aria-pressed=true]>.lucide-eye-off]:block"),
+		x.ButtonType("button"),
+		x.Aria("label", "Show password"),
+		x.Aria("pressed", "false"),
+		x.Data("password-toggle", ""),
+		x.Child(lucide.Eye(lucide.Size("16"), x.Class("lucide lucide-eye"))),
+		x.Child(lucide.EyeOff(lucide.Size("16"), x.Class("lucide lucide-eye-off hidden"))),
+	)
+
+	rootArgs := []x.DivArg{
+		x.Class("grid gap-2"),
+		x.Data("slot", "password-input"),
+		x.Child(x.Div(x.Class("relative"), x.Child(Input(inputArgs...)), x.Child(toggle))),
+		x.Child(x.P(
+			x.Class("flex items-center gap-1.5 text-xs text-amber-600 dark:text-amber-400"),
+			x.Role("status"),
+			x.Data("password-capslock", ""),
+			x.Hidden(),
+			x.Child(lucide.ArrowBigUp(lucide.Size("14"))),
+			x.T("Caps Lock is on"),
+		)),
+	}
+	if len(rules) > 0 {
+		rootArgs = append(rootArgs, x.Child(passwordMeter()), x.Child(passwordChecklist(rules)))
+	}
+
+	return x.Div(rootArgs...).WithAssets("", passwordJS, "password-input")
+}
+
+func passwordMeter() x.Node {
+	// Each bar lights up once the level reaches it; colour follows the overall level
+	bar := func(n int) x.DivArg {
+		return x.Child(x.Div(x.Class(fmt.Sprintf("h-1 flex-1 rounded-full bg-muted transition-colors group-data-[level=1]:[&:nth-child(-n+%[1]d)]:bg-destructive group-data-[level=2]:[&:nth-child(-n+%[1]d)]:bg-amber-500 group-data-[level=3]:[&:nth-child(-n+%[1]d)]:bg-lime-500 group-data-[level=4]:[&:nth-child(-n+%[1]d)]:bg-emerald-500", n))))
+	}
+	return x.Div(
+		x.Class("group flex items-center gap-3"),
+		x.Data("password-meter", ""),
+		x.Data("level", "0"),
+		x.Child(x.Div(x.Class("flex flex-1 gap-1"), x.Aria("hidden", "true"), bar(1), bar(2), bar(3), bar(4))),
+		x.Child(x.Span(x.Class("w-12 text-right text-xs text-muted-foreground"), x.Aria("live", "polite"), x.Data("password-strength", ""))),
+	)
+}
+
+func passwordChecklist(rules []PasswordRule) x.Node {
+	listArgs := []x.UlArg{x.Class("grid gap-1 text-xs text-muted-foreground"), x.Aria("label", "Password requirements")}
+	for _, r := range rules {
+		listArgs = append(listArgs, x.Child(x.Li(
+			x.Class("flex items-center gap-1.5 data-[met=true]:text-emerald-600 dark:data-[met=true]:text-emerald-400 [&[data-met=true]>.lucide-x]:hidden [&[data-met=true]>.lucide-check]:block"),
+			x.Data("password-rule", r.Pattern),
+			x.Child(lucide.X(lucide.Size("12"), x.Class("lucide lucide-x"))),
+			x.Child(lucide.Check(lucide.Size("12"), x.Class("lucide lucide-check hidden"))),
+			x.T(r.Label),
+		)))
+	}
+	return x.Ul(listArgs...)
+}
@@ -0,0 +1,155 @@
+package ui
+
+import (
+	"strconv"
+
+	x "github.com/plainkit/html"
+	"github.com/plainkit/icons/lucide"
+)
+
+const progressCSS = `
+@keyframes progress-indeterminate {
+    0% { transform: translateX(-100%); }
+    100% { transform: translateX(300%); }
+}`
+
+// Internal wrappers so Progress and CircularProgress can read their value
+type progressValueArg struct {
+	x.Global
+	value, max float64
+}
+
+type progressLabelArg struct{ x.Global }
+
+// ProgressValue makes the progress determinate: value out of max (100 when max <= 0).
+// Without it Progress and CircularProgress render indeterminate.
+func ProgressValue(value, max float64) x.DivArg {
+	if max <= 0 {
+		max = 100
+	}
+	if value < 0 {
+		value = 0
+	}
+	if value > max {
+		value = max
+	}
+	return progressValueArg{Global: x.Class(""), value: value, max: max}
+}
+
+// ProgressLabel shows the percentage inside CircularProgress
+func ProgressLabel() x.DivArg {
+	return progressLabelArg{Global: x.Class("")}
+}
+
+func (p progressValueArg) percent() float64 {
+	return p.value / p.max * 100
+}
+
+func (p progressValueArg) aria() []x.DivArg {
+	return []x.DivArg{
+		x.Aria("valuemin", "0"),
+		x.Aria("valuemax", strconv.FormatFloat(p.max, 'f', -1, 64)),
+		x.Aria("valuenow", strconv.FormatFloat(p.value, 'f', -1, 64)),
+		x.Data("state", "determinate"),
+	}
+}
+
+func progressOptions(args []x.DivArg) (*progressValueArg, bool) {
+	var value *progressValueArg
+	var label bool
+	for _, a := range args {
+		switch v := a.(type) {
+		case progressValueArg:
+			value = &v
+		case progressLabelArg:
+			label = true
+		}
+	}
+	return value, label
+}
+
+// Progress renders a horizontal bar with role="progressbar". Pass ProgressValue for a
+// determinate bar; without it the bar animates indeterminately. Name it with x.Aria("label", ...).
+func Progress(args ...x.DivArg) x.Node {
+	progressArgs := []x.DivArg{
+		x.Class("relative h-2 w-full overflow-hidden rounded-full bg-primary/20"),
+		x.Role("progressbar"),
+		x.Data("slot", "progress"),
+	}
+
+	value, _ := progressOptions(args)
+	indicator := []x.DivArg{x.Class("h-full bg-primary transition-[width]"), x.Data("slot", "progress-indicator")}
+	if value != nil {
+		progressArgs = append(progressArgs, value.aria()...)
+		indicator = append(indicator, x.Style("width", strconv.FormatFloat(value.percent(), 'f', 2, 64)+"%"))
+	} else {
+		progressArgs = append(progressArgs, x.Data("state", "indeterminate"))
+		indicator = append(indicator, x.Class("absolute inset-y-0 left-0 w-1/3 animate-[progress-indeterminate_1.5s_ease-in-out_infinite] motion-reduce:animate-none"))
+	}
+	progressArgs = append(progressArgs, x.Child(x.Div(indicator...)))
+	progressArgs = append(progressArgs, args...)
+
+	return x.Div(progressArgs...).WithAssets(progressCSS, "", "progress")
+}
+
+// CircularProgress renders a ring with role="progressbar"; ProgressValue fills it and
+// ProgressLabel shows the percentage in the middle. Size it with a class (default size-10).
+func CircularProgress(args ...x.DivArg) x.Node {
+	progressArgs := []x.DivArg{
+		x.Class("relative inline-flex size-10 shrink-0 items-center justify-center text-primary"),
+		x.Role("progressbar"),
+		x.Data("slot", "circular-progress"),
+	}
+
+	value, label := progressOptions(args)
+	ring := []x.CircleArg{
+		x.Cx("18"), x.Cy("18"), x.R("16"),
+		x.Fill("none"),
+		x.Stroke("currentColor"),
+		x.StrokeWidth("3"),
+		x.StrokeLinecap("round"),
+		x.PathLength("100"),
+	}
+	svgArgs := []x.SvgArg{
+		x.ViewBox("0 0 36 36"),
+		x.Class("size-full -rotate-90"),
+		x.Aria("hidden", "true"),
+		x.Child(x.Circle(x.Cx("18"), x.Cy("18"), x.R("16"), x.Fill("none"), x.Stroke("currentColor"), x.StrokeWidth("3"), x.Class("opacity-20"))),
+	}
+	if value != nil {
+		progressArgs = append(progressArgs, value.aria()...)
+		offset := strconv.FormatFloat(100-value.percent(), 'f', 2, 64)
+		ring = append(ring, x.StrokeDasharray("100"), x.StrokeDashoffset(offset), x.Class("transition-[stroke-dashoffset]"))
+	} else {
+		progressArgs = append(progressArgs, x.Data("state", "indeterminate"))
+		ring = append(ring, x.StrokeDasharray("25 75"))
+		svgArgs = append(svgArgs, x.Class("animate-spin motion-reduce:animate-none"))
+	}
+	svgArgs = append(svgArgs, x.Child(x.Circle(ring...)))
+	progressArgs = append(progressArgs, x.Child(x.Svg(svgArgs...)))
+
+	if label && value != nil {
+		progressArgs = append(progressArgs, x.Child(x.Span(
+			x.Class("absolute text-[0.6rem] font-medium tabular-nums text-foreground"),
+			x.Aria("hidden", "true"),
+			x.T(strconv.Itoa(int(value.percent()+0.5))+"%"),
+		)))
+	}
+	progressArgs = append(progressArgs, args...)
+
+	return x.Div(progressArgs...)
+}
+
+// Spinner renders a spinning loader icon announced as "Loading". Size it with a class on the
+// wrapper (e.g. x.Class("[&>svg]:size-6")); it inherits the text color.
+func Spinner(args ...x.SpanArg) x.Node {
+	spinnerArgs := []x.SpanArg{
+		x.Class("inline-flex [&>svg]:size-4 [&>svg]:animate-spin motion-reduce:[&>svg]:animate-none"),
+		x.Role("status"),
+		x.Aria("label", "Loading"),
+		x.Data("slot", "spinner"),
+		x.Child(lucide.LoaderCircle()),
+	}
+	spinnerArgs = append(spinnerArgs, args...)
+	return x.Span(spinnerArgs...)
+}
@@ -0,0 +1,13 @@
+package ui
+
+import x "github.com/plainkit/html"
+
+// Select renders a styled native select. Pass options as x.Child(x.Option(...)) children.
+// Since x.Select has no name option, set it with x.Custom("name", "...").
+func Select(args ...x.SelectArg) x.Node {
+	classes := "flex h-9 w-full items-center rounded-md border border-input bg-background dark:bg-input/30 px-3 py-1 text-base shadow-xs transition-[color,box-shadow] outline-none focus-visible:border-ring focus-visible:ring-ring/50 focus-visible:ring-[3px] aria-invalid:border-destructive aria-invalid:ring-destructive/20 dark:aria-invalid:ring-destructive/40 disabled:cursor-not-allowed disabled:opacity-50 md:text-sm"
+	selectArgs := []x.SelectArg{x.Class(classes)}
+	selectArgs = append(selectArgs, args...)
+
+	return x.Select(selectArgs...)
+}
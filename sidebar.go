@@ -0,0 +1,322 @@
+package ui
+
+import (
+	"net/http"
+
+	x "github.com/plainkit/html"
+	"github.com/plainkit/icons/lucide"
+)
+
+// SidebarCookie remembers whether the sidebar is collapsed so the server can render its width
+const SidebarCookie = "sidebar_state"
+
+const sidebarJS = `(function(){
+  const mobile = window.matchMedia('(max-width: 767px)');
+
+  function triggersFor(sb){
+    return document.querySelectorAll('[data-slot="sidebar-trigger"][aria-controls="'+sb.id+'"]');
+  }
+  function sync(sb){
+    const expanded = mobile.matches ? sb.dataset.mobile==='open' : sb.dataset.state!=='collapsed';
+    triggersFor(sb).forEach(t=>t.setAttribute('aria-expanded', expanded? 'true':'false'));
+  }
+  function setMobile(sb, open){
+    sb.dataset.mobile = open? 'open':'closed';
+    sync(sb);
+    if(open){
+      const first = sb.querySelector('a[href],button,summary');
+      if(first) first.focus();
+    }
+  }
+  function toggle(sb){
+    if(mobile.matches){ setMobile(sb, sb.dataset.mobile!=='open'); return; }
+    sb.dataset.state = sb.dataset.state==='collapsed'? 'expanded':'collapsed';
+    if(sb.dataset.cookie) document.cookie = sb.dataset.cookie+'='+sb.dataset.state+'; path=/; max-age=31536000; samesite=lax';
+    sync(sb);
+  }
+
+  document.addEventListener('click', e=>{
+    if(!e.target.closest) return;
+    const trigger = e.target.closest('[data-slot="sidebar-trigger"]');
+    if(trigger){
+      const sb = document.getElementById(trigger.getAttribute('aria-controls')||'');
+      if(sb) toggle(sb);
+      return;
+    }
+    const overlay = e.target.closest('[data-slot="sidebar-overlay"]');
+    if(overlay){
+      const sb = overlay.closest('[data-slot="sidebar"]');
+      setMobile(sb, false);
+      const t = triggersFor(sb)[0];
+      if(t) t.focus();
+    }
+  });
+  document.addEventListener('keydown', e=>{
+    if(e.key==='Escape'){
+      document.querySelectorAll('[data-slot="sidebar"][data-mobile="open"]').forEach(sb=>{
+        setMobile(sb, false);
+        const t = triggersFor(sb)[0];
+        if(t) t.focus();
+      });
+    }
+    // Ctrl/Cmd+B toggles the first sidebar that takes the shortcut, as in most editors, but not
+    // while typing where it means bold
+    const t = e.target;
+    const editing = t.isContentEditable || (t.closest && t.closest('input,textarea,select'));
+    if((e.ctrlKey||e.metaKey) && e.key.toLowerCase()==='b' && !editing){
+      const sb = document.querySelector('[data-slot="sidebar"][data-shortcut="true"]');
+      if(sb){ e.preventDefault(); toggle(sb); }
+    }
+  });
+  mobile.addEventListener('change', ()=>{
+    document.querySelectorAll('[data-slot="sidebar"]').forEach(sb=>{ sb.dataset.mobile='closed'; sync(sb); });
+  });
+
+  function init(){ document.querySelectorAll('[data-slot="sidebar"]').forEach(sync); }
+  if(document.readyState==='loading'){
+    document.addEventListener('DOMContentLoaded', init);
+  } else {
+    init();
+  }
+})();`
+
+// sidebarCollapsedHidden hides an element while the desktop sidebar is collapsed to icons
+const sidebarCollapsedHidden = "md:group-data-[state=collapsed]/sidebar:hidden"
+
+const sidebarMenuButtonBase = "flex w-full items-center gap-2 overflow-hidden rounded-md p-2 text-left text-sm outline-none ring-sidebar-ring transition-[width,height,padding] hover:bg-sidebar-accent hover:text-sidebar-accent-foreground focus-visible:ring-2 aria-[current=page]:bg-sidebar-accent aria-[current=page]:font-medium aria-[current=page]:text-sidebar-accent-foreground group-has-[[data-slot=sidebar-menu-badge]]/menu-item:pr-8 [&>svg]:size-4 [&>svg]:shrink-0 [&>span]:truncate md:group-data-[state=collapsed]/sidebar:size-8 md:group-data-[state=collapsed]/sidebar:[&>span]:hidden"
+
+// SidebarState restores the collapsed state from SidebarCookie; pass it to Sidebar
+func SidebarState(r *http.Request) x.AsideArg {
+	if sidebarCollapsed(r) {
+		return SidebarCollapsed()
+	}
+	return x.Data("state", "expanded")
+}
+
+// SidebarTriggerState matches the trigger's aria-expanded to SidebarState; pass it to SidebarTrigger
+func SidebarTriggerState(r *http.Request) x.ButtonArg {
+	if sidebarCollapsed(r) {
+		return x.Aria("expanded", "false")
+	}
+	return x.Aria("expanded", "true")
+}
+
+func sidebarCollapsed(r *http.Request) bool {
+	c, err := r.Cookie(SidebarCookie)
+	return err == nil && c.Value == "collapsed"
+}
+
+// SidebarCollapsed renders the sidebar collapsed to icons on desktop
+func SidebarCollapsed() x.AsideArg {
+	return x.Data("state", "collapsed")
+}
+
+// SidebarNoShortcut leaves the sidebar out of Ctrl/Cmd+B; with several sidebars on a page,
+// pass it to all but the one the shortcut should toggle
+func SidebarNoShortcut() x.AsideArg {
+	return x.Data("shortcut", "false")
+}
+
+// Sidebar renders the app sidebar; SidebarTrigger points at it by id. On desktop it collapses
+// to icons; below the md breakpoint it is off-canvas and SidebarTrigger slides it in over the page.
+// Ctrl/Cmd+B toggles the first sidebar on the page (see SidebarNoShortcut), and the desktop
+// state is remembered in SidebarCookie. Pass SidebarHeader, SidebarContent and SidebarFooter via x.Child.
+func Sidebar(id string, args ...x.AsideArg) x.Node {
+	classes := "group/sidebar z-40 flex h-svh shrink-0 flex-col border-r border-sidebar-border bg-sidebar text-sidebar-foreground transition-[left,width,visibility] duration-200 ease-linear " +
+		"max-md:invisible max-md:fixed max-md:inset-y-0 max-md:left-[calc(var(--sidebar-width)*-1)] max-md:w-(--sidebar-width) max-md:data-[mobile=open]:visible max-md:data-[mobile=open]:left-0 max-md:data-[mobile=open]:shadow-lg " +
+		"md:sticky md:top-0 md:w-(--sidebar-width) md:data-[state=collapsed]:w-(--sidebar-width-icon)"
+	sidebarArgs := []x.AsideArg{
+		x.Class(classes),
+		x.Id(id),
+		x.Aria("label", "Sidebar"),
+		x.Style("--sidebar-width", "16rem"),
+		x.Style("--sidebar-width-icon", "3rem"),
+		x.Data("slot", "sidebar"),
+		x.Data("state", "expanded"),
+		x.Data("mobile", "closed"),
+		x.Data("shortcut", "true"),
+		x.Data("cookie", SidebarCookie),
+		// covers the page beside the open mobile sidebar; clicking it closes the sidebar
+		x.Child(x.Div(
+			x.Class("fixed inset-y-0 right-0 left-(--sidebar-width) hidden bg-black/50 group-data-[mobile=open]/sidebar:block md:hidden"),
+			x.Aria("hidden", "true"),
+			x.Data("slot", "sidebar-overlay"),
+		)),
+	}
+	sidebarArgs = append(sidebarArgs, args...)
+
+	return x.Aside(sidebarArgs...).WithAssets("", sidebarJS, "sidebar")
+}
+
+// SidebarTrigger toggles the sidebar with sidebarID: collapse on desktop, slide in on mobile
+// (also Ctrl/Cmd+B). It starts expanded; pass SidebarTriggerState when the sidebar may render
+// collapsed, or x.Aria("expanded", "false") alongside SidebarCollapsed. The script that drives it
+// ships with Sidebar.
+func SidebarTrigger(sidebarID string, args ...x.ButtonArg) x.Node {
+	triggerArgs := []x.ButtonArg{
+		ButtonClass(ButtonGhost(), ButtonIcon()),
+		x.ButtonType("button"),
+		x.Aria("label", "Toggle sidebar"),
+		x.Aria("controls", sidebarID),
+		x.Aria("expanded", "true"),
+		x.Data("slot", "sidebar-trigger"),
+		x.Child(lucide.PanelLeft()),
+	}
+	triggerArgs = append(triggerArgs, args...)
+	return x.Button(triggerArgs...)
+}
+
+// SidebarHeader renders the top of the sidebar (logo, team switcher)
+func SidebarHeader(args ...x.DivArg) x.Node {
+	headerArgs := append([]x.DivArg{x.Class("flex flex-col gap-2 p-2"), x.Data("slot", "sidebar-header")}, args...)
+	return x.Div(headerArgs...)
+}
+
+// SidebarContent renders the scrollable middle of the sidebar holding SidebarGroups
+func SidebarContent(args ...x.DivArg) x.Node {
+	contentArgs := append([]x.DivArg{
+		x.Class("flex min-h-0 flex-1 flex-col gap-2 overflow-auto md:group-data-[state=collapsed]/sidebar:overflow-hidden"),
+		x.Data("slot", "sidebar-content"),
+	}, args...)
+	return x.Div(contentArgs...)
+}
+
+// SidebarFooter renders the bottom of the sidebar (user menu, settings)
+func SidebarFooter(args ...x.DivArg) x.Node {
+	footerArgs := append([]x.DivArg{x.Class("flex flex-col gap-2 p-2"), x.Data("slot", "sidebar-footer")}, args...)
+	return x.Div(footerArgs...)
+}
+
+// SidebarGroup renders a section of the sidebar; pass a SidebarGroupLabel and a SidebarMenu
+func SidebarGroup(args ...x.DivArg) x.Node {
+	groupArgs := append([]x.DivArg{x.Class("relative flex w-full min-w-0 flex-col p-2"), x.Data("slot", "sidebar-group")}, args...)
+	return x.Div(groupArgs...)
+}
+
+// SidebarGroupLabel renders the group heading, hidden while collapsed
+func SidebarGroupLabel(args ...x.DivArg) x.Node {
+	labelArgs := append([]x.DivArg{
+		x.Class("flex h-8 shrink-0 items-center rounded-md px-2 text-xs font-medium text-sidebar-foreground/70 " + sidebarCollapsedHidden),
+		x.Data("slot", "sidebar-group-label"),
+	}, args...)
+	return x.Div(labelArgs...)
+}
+
+// SidebarMenu renders a list of SidebarMenuItems
+func SidebarMenu(args ...x.UlArg) x.Node {
+	menuArgs := append([]x.UlArg{x.Class("flex w-full min-w-0 flex-col gap-1"), x.Data("slot", "sidebar-menu")}, args...)
+	return x.Ul(menuArgs...)
+}
+
+// SidebarMenuItem wraps a SidebarMenuButton or SidebarSubmenu and an optional SidebarMenuBadge
+func SidebarMenuItem(args ...x.LiArg) x.Node {
+	itemArgs := append([]x.LiArg{x.Class("group/menu-item relative"), x.Data("slot", "sidebar-menu-item")}, args...)
+	return x.Li(itemArgs...)
+}
+
+// SidebarMenuActive marks the link to the current page
+func SidebarMenuActive() x.AArg {
+	return x.Aria("current", "page")
+}
+
+// SidebarMenuButton renders a menu link. Pass an icon and the label in an x.Span; the label is
+// hidden while collapsed, so also pass x.Title(label) to keep a hover hint for the icon.
+func SidebarMenuButton(href string, args ...x.AArg) x.Node {
+	buttonArgs := []x.AArg{
+		x.Class(sidebarMenuButtonBase + " h-8"),
+		x.Href(href),
+		x.Data("slot", "sidebar-menu-button"),
+	}
+	buttonArgs = append(buttonArgs, args...)
+	return x.A(buttonArgs...)
+}
+
+// SidebarMenuBadge renders a count or label at the end of a menu item
+func SidebarMenuBadge(args ...x.SpanArg) x.Node {
+	badgeArgs := []x.SpanArg{
+		x.Class("pointer-events-none absolute top-1.5 right-1 flex h-5 min-w-5 select-none items-center justify-center rounded-md px-1 text-xs font-medium tabular-nums text-sidebar-foreground " + sidebarCollapsedHidden),
+		x.Data("slot", "sidebar-menu-badge"),
+	}
+	badgeArgs = append(badgeArgs, args...)
+	return x.Span(badgeArgs...)
+}
+
+// SidebarSubmenu renders a collapsible menu item; pass a SidebarSubmenuTrigger and a
+// SidebarMenuSub via x.Child, and x.Open() to render it expanded
+func SidebarSubmenu(args ...x.DetailsArg) x.Node {
+	submenuArgs := append([]x.DetailsArg{x.Class("group/submenu"), x.Data("slot", "sidebar-submenu")}, args...)
+	return x.Details(submenuArgs...)
+}
+
+// SidebarSubmenuTrigger renders the submenu's toggle row with a rotating chevron
+func SidebarSubmenuTrigger(args ...x.SummaryArg) x.Node {
+	triggerArgs := []x.SummaryArg{
+		x.Class(sidebarMenuButtonBase + " h-8 cursor-pointer list-none [&::-webkit-details-marker]:hidden"),
+		x.Data("slot", "sidebar-submenu-trigger"),
+	}
+	triggerArgs = append(triggerArgs, args...)
+	triggerArgs = append(triggerArgs, x.Child(x.Span(
+		x.Class("ml-auto transition-transform group-open/submenu:rotate-90 [&>svg]:size-4"),
+		x.Aria("hidden", "true"),
+		x.Child(lucide.ChevronRight()),
+	)))
+	return x.Summary(triggerArgs...)
+}
+
+// SidebarMenuSub renders the nested list of a submenu, hidden while collapsed
+func SidebarMenuSub(args ...x.UlArg) x.Node {
+	subArgs := append([]x.UlArg{
+		x.Class("mx-3.5 mt-1 flex min-w-0 translate-x-px flex-col gap-1 border-l border-sidebar-border px-2.5 py-0.5 " + sidebarCollapsedHidden),
+		x.Data("slot", "sidebar-menu-sub"),
+	}, args...)
+	return x.Ul(subArgs...)
+}
+
+// SidebarMenuSubItem wraps a SidebarMenuSubButton
+func SidebarMenuSubItem(args ...x.LiArg) x.Node {
+	itemArgs := append([]x.LiArg{x.Data("slot", "sidebar-menu-sub-item")}, args...)
+	return x.Li(itemArgs...)
+}
+
+// SidebarMenuSubButton renders a nested menu link; mark the current page with SidebarMenuActive
+func SidebarMenuSubButton(href string, args ...x.AArg) x.Node {
+	buttonArgs := []x.AArg{
+		x.Class("flex h-7 min-w-0 items-center gap-2 overflow-hidden rounded-md px-2 text-sm text-sidebar-foreground outline-none ring-sidebar-ring hover:bg-sidebar-accent hover:text-sidebar-accent-foreground focus-visible:ring-2 aria-[current=page]:bg-sidebar-accent aria-[current=page]:text-sidebar-accent-foreground [&>svg]:size-4 [&>svg]:shrink-0 [&>span]:truncate"),
+		x.Href(href),
+		x.Data("slot", "sidebar-menu-sub-button"),
+	}
+	buttonArgs = append(buttonArgs, args...)
+	return x.A(buttonArgs...)
+}
+
+// AppShellHeader renders the sticky top bar with trigger, usually a SidebarTrigger, before its children
+func AppShellHeader(trigger x.Node, args ...x.HeaderArg) x.Node {
+	headerArgs := []x.HeaderArg{
+		x.Class("sticky top-0 z-30 flex h-14 shrink-0 items-center gap-2 border-b bg-background/95 px-4 backdrop-blur"),
+		x.Data("slot", "app-shell-header"),
+		x.Child(trigger),
+	}
+	headerArgs = append(headerArgs, args...)
+	return x.Header(headerArgs...)
+}
+
+// AppShell lays out a full-height page: sidebar on the left, header above the main content.
+// Pass a Sidebar and an AppShellHeader; args go to the <main> element.
+func AppShell(sidebar, header x.Node, args ...x.MainArg) x.Node {
+	mainArgs := []x.MainArg{
+		x.Class("flex-1 p-4 md:p-6"),
+		x.Data("slot", "app-shell-main"),
+	}
+	mainArgs = append(mainArgs, args...)
+
+	return x.Div(
+		x.Class("flex min-h-svh w-full"),
+		x.Data("slot", "app-shell"),
+		x.Child(sidebar),
+		x.Child(x.Div(
+			x.Class("flex min-w-0 flex-1 flex-col"),
+			x.Child(header),
+			x.Child(x.Main(mainArgs...)),
+		)),
+	)
+}
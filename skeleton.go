@@ -0,0 +1,128 @@
+package ui
+
+import x "github.com/plainkit/html"
+
+const skeletonCSS = `
+[data-skeleton=shimmer] [data-slot=skeleton],
+[data-slot=skeleton][data-skeleton=shimmer] {
+    position: relative;
+    overflow: hidden;
+    animation: none;
+}
+
+[data-skeleton=shimmer] [data-slot=skeleton]::after,
+[data-slot=skeleton][data-skeleton=shimmer]::after {
+    content: "";
+    position: absolute;
+    inset: 0;
+    transform: translateX(-100%);
+    background: linear-gradient(90deg, transparent, rgb(255 255 255 / 0.5), transparent);
+    animation: skeleton-shimmer 1.5s infinite;
+}
+
+.dark [data-skeleton=shimmer] [data-slot=skeleton]::after,
+.dark [data-slot=skeleton][data-skeleton=shimmer]::after {
+    background: linear-gradient(90deg, transparent, rgb(255 255 255 / 0.08), transparent);
+}
+
+@keyframes skeleton-shimmer {
+    100% { transform: translateX(100%); }
+}
+
+@media (prefers-reduced-motion: reduce) {
+    [data-slot=skeleton]::after { animation: none !important; }
+}`
+
+// Shapes (prefixed). Override the size with a class, e.g. x.Class("h-6 w-32").
+
+func SkeletonLine() x.DivArg {
+	return x.Class("h-4 w-full rounded")
+}
+
+func SkeletonCircle() x.DivArg {
+	return x.Class("size-10 shrink-0 rounded-full")
+}
+
+func SkeletonRect() x.DivArg {
+	return x.Class("h-24 w-full rounded-md")
+}
+
+// SkeletonShimmer swaps the pulse for a sweeping highlight. Put it on a single Skeleton or on
+// any container (including the prebuilt layouts) to apply it to every skeleton inside.
+func SkeletonShimmer() x.DivArg {
+	return x.Data("skeleton", "shimmer")
+}
+
+// Skeleton renders a pulsing placeholder block, hidden from assistive tech.
+// Pass a shape (SkeletonLine, SkeletonCircle, SkeletonRect) or size and round it with classes.
+func Skeleton(args ...x.DivArg) x.Node {
+	skeletonArgs := []x.DivArg{
+		x.Class("animate-pulse bg-accent motion-reduce:animate-none"),
+		x.Data("slot", "skeleton"),
+		x.Aria("hidden", "true"),
+	}
+	skeletonArgs = append(skeletonArgs, args...)
+
+	return x.Div(skeletonArgs...).WithAssets(skeletonCSS, "", "skeleton")
+}
+
+// skeletonWidths vary line lengths so stacked placeholders read as text
+var skeletonWidths = []string{"w-3/4", "w-1/2", "w-5/6", "w-2/3"}
+
+func skeletonLine(i int) x.Node {
+	return Skeleton(x.Class("h-4 rounded " + skeletonWidths[i%len(skeletonWidths)]))
+}
+
+// skeletonStatus marks a placeholder region as loading for screen readers
+func skeletonStatus() []x.DivArg {
+	return []x.DivArg{
+		x.Role("status"),
+		x.Aria("busy", "true"),
+		x.Aria("label", "Loading"),
+	}
+}
+
+// SkeletonCard renders a placeholder shaped like a Card with a header and body
+func SkeletonCard(args ...x.DivArg) x.Node {
+	cardArgs := append(skeletonStatus(),
+		x.Child(x.Div(
+			x.Class("flex items-center gap-4 p-6"),
+			x.Child(Skeleton(SkeletonCircle())),
+			x.Child(x.Div(x.Class("grid flex-1 gap-2"), x.Child(skeletonLine(1)), x.Child(skeletonLine(0)))),
+		)),
+		x.Child(x.Div(x.Class("grid gap-2 p-6 pt-0"), x.Child(Skeleton(SkeletonRect())), x.Child(skeletonLine(2)), x.Child(skeletonLine(3)))),
+	)
+	cardArgs = append(cardArgs, args...)
+	return Card(cardArgs...)
+}
+
+// SkeletonTableRows renders a tbody of rows × cols placeholder cells for a table that is loading
+func SkeletonTableRows(rows, cols int, args ...x.TbodyArg) x.Node {
+	bodyArgs := []x.TbodyArg{x.Aria("busy", "true"), x.Data("slot", "skeleton-table-rows")}
+	for r := 0; r < rows; r++ {
+		rowArgs := []x.TrArg{x.Class("border-b")}
+		for c := 0; c < cols; c++ {
+			rowArgs = append(rowArgs, x.Child(x.Td(
+				x.Class("p-2"),
+				x.Child(skeletonLine(r+c)),
+			)))
+		}
+		bodyArgs = append(bodyArgs, x.Child(x.Tr(rowArgs...)))
+	}
+	bodyArgs = append(bodyArgs, args...)
+	return x.Tbody(bodyArgs...)
+}
+
+// SkeletonList renders n placeholder list items, each an avatar circle with two lines of text
+func SkeletonList(n int, args ...x.DivArg) x.Node {
+	listArgs := append([]x.DivArg{x.Class("grid gap-4")}, skeletonStatus()...)
+	for i := 0; i < n; i++ {
+		listArgs = append(listArgs, x.Child(x.Div(
+			x.Class("flex items-center gap-4"),
+			x.Child(Skeleton(SkeletonCircle())),
+			x.Child(x.Div(x.Class("grid flex-1 gap-2"), x.Child(skeletonLine(i)), x.Child(skeletonLine(i+1)))),
+		)))
+	}
+	listArgs = append(listArgs, args...)
+	return x.Div(listArgs...)
+}
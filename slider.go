@@ -0,0 +1,400 @@
+package ui
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	x "github.com/plainkit/html"
+)
+
+const sliderCSS = `
+.slider-control {
+    position: relative;
+    display: flex;
+    align-items: center;
+    width: 100%;
+    height: 1.25rem;
+}
+
+.slider-track {
+    position: absolute;
+    left: 0.5rem;
+    right: 0.5rem;
+    top: 50%;
+    height: 0.375rem;
+    transform: translateY(-50%);
+    border-radius: 9999px;
+    background: var(--color-muted, #f4f4f5);
+}
+
+.slider-range {
+    position: absolute;
+    top: 0;
+    bottom: 0;
+    left: var(--slider-lo, 0%);
+    right: calc(100% - var(--slider-hi, 0%));
+    border-radius: inherit;
+    background: var(--color-primary, #18181b);
+}
+
+.slider-mark,
+.slider-tick {
+    position: absolute;
+    left: var(--slider-pos);
+    transform: translateX(-50%);
+}
+
+.slider-mark {
+    top: 50%;
+    width: 0.25rem;
+    height: 0.25rem;
+    margin-top: -0.125rem;
+    border-radius: 9999px;
+    background: var(--color-muted-foreground, #71717a);
+    opacity: 0.5;
+}
+
+.slider-ticks {
+    position: relative;
+    height: 1rem;
+    margin-inline: 0.5rem;
+}
+
+.slider-input {
+    position: absolute;
+    inset: 0;
+    width: 100%;
+    height: 100%;
+    margin: 0;
+    background: transparent;
+    -webkit-appearance: none;
+    appearance: none;
+    pointer-events: none;
+}
+
+/* a single thumb lets the whole track take clicks; range thumbs must not block each other */
+.slider-input:only-of-type { pointer-events: auto; }
+
+.slider-input::-webkit-slider-runnable-track { background: transparent; }
+.slider-input::-moz-range-track { background: transparent; }
+
+.slider-input::-webkit-slider-thumb {
+    -webkit-appearance: none;
+    pointer-events: auto;
+    width: 1rem;
+    height: 1rem;
+    border: 1px solid var(--color-primary, #18181b);
+    border-radius: 9999px;
+    background: var(--color-background, #fff);
+    box-shadow: 0 1px 2px rgb(0 0 0 / 0.1);
+    cursor: pointer;
+    transition: box-shadow 150ms;
+}
+
+.slider-input::-moz-range-thumb {
+    pointer-events: auto;
+    width: 1rem;
+    height: 1rem;
+    border: 1px solid var(--color-primary, #18181b);
+    border-radius: 9999px;
+    background: var(--color-background, #fff);
+    box-shadow: 0 1px 2px rgb(0 0 0 / 0.1);
+    cursor: pointer;
+    transition: box-shadow 150ms;
+}
+
+.slider-input:focus-visible { outline: none; }
+.slider-input:focus-visible::-webkit-slider-thumb { box-shadow: 0 0 0 4px color-mix(in oklab, var(--color-ring, #a1a1aa) 50%, transparent); }
+.slider-input:focus-visible::-moz-range-thumb { box-shadow: 0 0 0 4px color-mix(in oklab, var(--color-ring, #a1a1aa) 50%, transparent); }
+.slider-input:disabled::-webkit-slider-thumb { cursor: not-allowed; }
+.slider-input:disabled::-moz-range-thumb { cursor: not-allowed; }
+
+[data-slot="slider"][data-orientation="vertical"] .slider-control {
+    width: 1.25rem;
+    height: 10rem;
+}
+
+[data-slot="slider"][data-orientation="vertical"] .slider-track {
+    left: 50%;
+    right: auto;
+    top: 0.5rem;
+    bottom: 0.5rem;
+    width: 0.375rem;
+    height: auto;
+    transform: translateX(-50%);
+}
+
+[data-slot="slider"][data-orientation="vertical"] .slider-range {
+    left: 0;
+    right: 0;
+    top: calc(100% - var(--slider-hi, 0%));
+    bottom: var(--slider-lo, 0%);
+}
+
+[data-slot="slider"][data-orientation="vertical"] .slider-mark,
+[data-slot="slider"][data-orientation="vertical"] .slider-tick {
+    left: auto;
+    bottom: var(--slider-pos);
+    transform: translateY(50%);
+}
+
+[data-slot="slider"][data-orientation="vertical"] .slider-mark {
+    top: auto;
+    left: 50%;
+    margin: 0 0 0 -0.125rem;
+}
+
+[data-slot="slider"][data-orientation="vertical"] .slider-ticks {
+    width: 2rem;
+    height: auto;
+    margin: 0.5rem 0;
+}
+
+[data-slot="slider"][data-orientation="vertical"] .slider-input {
+    writing-mode: vertical-lr;
+    direction: rtl;
+}`
+
+const sliderJS = `(function(){
+  function pct(input){
+    const min = parseFloat(input.min||'0'), max = parseFloat(input.max||'100');
+    return max>min ? ((parseFloat(input.value)-min)/(max-min))*100 : 0;
+  }
+
+  function update(root, moved){
+    const inputs = root.querySelectorAll('input[type="range"]');
+    if(!inputs.length) return;
+    let lo = 0, hi;
+    if(inputs.length>1){
+      const a = inputs[0], b = inputs[1];
+      // keep the thumbs from crossing
+      if(parseFloat(a.value)>parseFloat(b.value)){
+        if(moved===b) b.value = a.value; else a.value = b.value;
+      }
+      lo = pct(a); hi = pct(b);
+      // when both thumbs sit at the max, keep the lower one on top so it can be dragged back
+      a.style.zIndex = lo>=100 ? '1' : '';
+    } else {
+      hi = pct(inputs[0]);
+    }
+    root.style.setProperty('--slider-lo', lo+'%');
+    root.style.setProperty('--slider-hi', hi+'%');
+    const out = root.querySelector('[data-slot="slider-value"]');
+    if(out) out.textContent = Array.from(inputs).map(i=>i.value).join(' – ');
+  }
+
+  function init(root){
+    root.querySelectorAll('input[type="range"]').forEach(input=>{
+      input.addEventListener('input', ()=>update(root, input));
+    });
+    update(root, null);
+  }
+
+  if(document.readyState==='loading'){
+    document.addEventListener('DOMContentLoaded',()=>{
+      document.querySelectorAll('[data-slot="slider"]').forEach(init);
+    });
+  }else{
+    document.querySelectorAll('[data-slot="slider"]').forEach(init);
+  }
+})();`
+
+type sliderOpts struct {
+	min, max, step float64
+	value          *float64
+	lo, hi         *float64
+	vertical       bool
+	readout        bool
+	stepMarks      bool
+	ticks          []string
+}
+
+// Internal wrapper so Slider can pick its own options out of x.InputArg
+type sliderArg struct {
+	x.Global
+	apply func(*sliderOpts)
+}
+
+func newSliderArg(apply func(*sliderOpts)) x.InputArg {
+	return sliderArg{Global: x.Class(""), apply: apply}
+}
+
+// SliderBounds sets min, max and step (defaults 0, 100, 1); also used to place marks, ticks and the fill.
+func SliderBounds(min, max, step float64) x.InputArg {
+	return newSliderArg(func(o *sliderOpts) { o.min, o.max, o.step = min, max, step })
+}
+
+// SliderValue sets the initial value of a Slider. Prefer it over x.InputValue so the
+// fill and readout render correctly before any JS runs.
+func SliderValue(v float64) x.InputArg {
+	return newSliderArg(func(o *sliderOpts) { o.value = &v })
+}
+
+// RangeSliderValue sets the initial lower and upper values of a RangeSlider
+func RangeSliderValue(lo, hi float64) x.InputArg {
+	return newSliderArg(func(o *sliderOpts) { o.lo, o.hi = &lo, &hi })
+}
+
+// SliderVertical renders the slider bottom-to-top
+func SliderVertical() x.InputArg {
+	return newSliderArg(func(o *sliderOpts) { o.vertical = true })
+}
+
+// SliderReadout shows the current value(s) next to the slider, updated live
+func SliderReadout() x.InputArg {
+	return newSliderArg(func(o *sliderOpts) { o.readout = true })
+}
+
+// SliderStepMarks draws a mark on the track at every step (skipped above 100 steps)
+func SliderStepMarks() x.InputArg {
+	return newSliderArg(func(o *sliderOpts) { o.stepMarks = true })
+}
+
+// SliderTicks renders labels spaced evenly from min to max, e.g. SliderTicks("0", "50", "100")
+func SliderTicks(labels ...string) x.InputArg {
+	return newSliderArg(func(o *sliderOpts) { o.ticks = labels })
+}
+
+// Slider renders a themed native <input type="range">. Pass input attributes via x.InputArg
+// (Id, Name, Disabled, aria-label, etc.) together with the Slider* options.
+func Slider(args ...x.InputArg) x.Node {
+	o, inputArgs := sliderOptions(args)
+
+	value := (o.min + o.max) / 2 // browser default when no value is given
+	if o.value != nil {
+		value = *o.value
+	}
+
+	base := append(o.baseInputArgs(), x.InputValue(formatSliderNumber(value)))
+	input := x.Input(append(base, inputArgs...)...)
+	return o.render([]string{formatSliderNumber(value)}, o.min, value, input)
+}
+
+// RangeSlider renders a dual-thumb slider posting the lower value as minName and the upper
+// as maxName. Shared x.InputArg (Disabled, Slider* options, etc.) apply to both thumbs;
+// set initial values with RangeSliderValue. Don't pass x.Id, as it would be duplicated.
+func RangeSlider(minName, maxName string, args ...x.InputArg) x.Node {
+	o, inputArgs := sliderOptions(args)
+
+	lo, hi := o.min, o.max
+	if o.lo != nil && o.hi != nil {
+		lo, hi = *o.lo, *o.hi
+	}
+
+	lower := append(o.baseInputArgs(),
+		x.InputName(minName),
+		x.InputValue(formatSliderNumber(lo)),
+		x.Aria("label", "Minimum"),
+	)
+	upper := append(o.baseInputArgs(),
+		x.InputName(maxName),
+		x.InputValue(formatSliderNumber(hi)),
+		x.Aria("label", "Maximum"),
+	)
+
+	return o.render(
+		[]string{formatSliderNumber(lo), formatSliderNumber(hi)},
+		lo, hi,
+		x.Input(append(lower, inputArgs...)...),
+		x.Input(append(upper, inputArgs...)...),
+	)
+}
+
+// sliderOptions splits Slider* options from the plain input attributes
+func sliderOptions(args []x.InputArg) (sliderOpts, []x.InputArg) {
+	o := sliderOpts{min: 0, max: 100, step: 1}
+	var inputArgs []x.InputArg
+	for _, a := range args {
+		if s, ok := a.(sliderArg); ok {
+			s.apply(&o)
+			continue
+		}
+		inputArgs = append(inputArgs, a)
+	}
+	return o, inputArgs
+}
+
+func (o sliderOpts) baseInputArgs() []x.InputArg {
+	inputArgs := []x.InputArg{
+		x.Class("slider-input"),
+		x.InputType("range"),
+		x.Min(formatSliderNumber(o.min)),
+		x.Max(formatSliderNumber(o.max)),
+		x.Step(formatSliderNumber(o.step)),
+	}
+	if o.vertical {
+		inputArgs = append(inputArgs, x.Aria("orientation", "vertical"))
+	}
+	return inputArgs
+}
+
+// percent maps v onto the track as a CSS percentage
+func (o sliderOpts) percent(v float64) string {
+	p := 0.0
+	if o.max > o.min {
+		p = (v - o.min) / (o.max - o.min) * 100
+	}
+	if p < 0 {
+		p = 0
+	} else if p > 100 {
+		p = 100
+	}
+	return strconv.FormatFloat(p, 'f', 2, 64) + "%"
+}
+
+func (o sliderOpts) render(values []string, lo, hi float64, inputs ...x.Node) x.Node {
+	orientation := "horizontal"
+	if o.vertical {
+		orientation = "vertical"
+	}
+
+	track := []x.DivArg{x.Class("slider-track"), x.Div(x.Class("slider-range"))}
+	if o.stepMarks && o.step > 0 && (o.max-o.min)/o.step <= 100 {
+		steps := int(math.Round((o.max - o.min) / o.step))
+		for i := 0; i <= steps; i++ {
+			v := o.min + float64(i)*o.step
+			track = append(track, x.Span(x.Class("slider-mark"), x.Style("--slider-pos", o.percent(v))))
+		}
+	}
+
+	control := []x.DivArg{x.Class("slider-control"), x.Div(track...)}
+	for _, in := range inputs {
+		control = append(control, in)
+	}
+
+	rootArgs := []x.DivArg{
+		x.Class("flex w-full flex-col gap-2 text-sm data-[orientation=vertical]:w-auto data-[orientation=vertical]:flex-row has-[:disabled]:opacity-50"),
+		x.Data("slot", "slider"),
+		x.Data("orientation", orientation),
+		x.Style("--slider-lo", o.percent(lo)),
+		x.Style("--slider-hi", o.percent(hi)),
+		x.Div(control...),
+	}
+
+	if len(o.ticks) > 0 {
+		ticks := []x.DivArg{x.Class("slider-ticks text-xs text-muted-foreground"), x.Aria("hidden", "true")}
+		for i, label := range o.ticks {
+			pos := 0.0
+			if len(o.ticks) > 1 {
+				pos = o.min + (o.max-o.min)*float64(i)/float64(len(o.ticks)-1)
+			}
+			ticks = append(ticks, x.Span(x.Class("slider-tick"), x.Style("--slider-pos", o.percent(pos)), x.T(label)))
+		}
+		rootArgs = append(rootArgs, x.Div(ticks...))
+	}
+
+	if o.readout {
+		rootArgs = append(rootArgs, x.Span(
+			x.Class("font-medium tabular-nums text-muted-foreground"),
+			x.Data("slot", "slider-value"),
+			x.T(strings.Join(values, " – ")),
+		))
+	}
+
+	return x.Div(rootArgs...).WithAssets(sliderCSS, sliderJS, "slider")
+}
+
+func formatSliderNumber(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
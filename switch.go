@@ -0,0 +1,88 @@
+package ui
+
+import x "github.com/plainkit/html"
+
+// switchJS keeps readonly switches from toggling by keyboard; the mouse is stopped in CSS.
+// Space fires click on a checkbox, and cancelling that click restores the checked state.
+const switchJS = `(function(){
+  document.addEventListener('click', e=>{
+    if(e.target.matches && e.target.matches('[data-slot="switch"] > input[aria-readonly="true"]')) e.preventDefault();
+  }, true);
+})();`
+
+// Internal wrapper so Switch can pick sizes out of its x.InputArg and apply them to the container
+type switchSizeArg struct {
+	x.Global
+	cls string
+}
+
+// Sizes (prefixed). Each sets the track, the thumb and how far the thumb travels when checked.
+
+func SwitchDefaultSize() x.InputArg {
+	s := "[&>.switch-track]:h-5 [&>.switch-track]:w-9 [&_.switch-thumb]:size-4 [&>input:checked~.switch-track_.switch-thumb]:translate-x-4"
+	return switchSizeArg{Global: x.Class(s), cls: s}
+}
+
+func SwitchSm() x.InputArg {
+	s := "[&>.switch-track]:h-4 [&>.switch-track]:w-7 [&_.switch-thumb]:size-3 [&>input:checked~.switch-track_.switch-thumb]:translate-x-3"
+	return switchSizeArg{Global: x.Class(s), cls: s}
+}
+
+func SwitchLg() x.InputArg {
+	s := "[&>.switch-track]:h-6 [&>.switch-track]:w-11 [&_.switch-thumb]:size-5 [&>input:checked~.switch-track_.switch-thumb]:translate-x-5"
+	return switchSizeArg{Global: x.Class(s), cls: s}
+}
+
+// Switch renders a toggle switch driven by a hidden native checkbox with role="switch",
+// using the same sibling-selector technique as Checkbox. Pass input attributes via x.InputArg
+// (Id, Name, Checked, Disabled, etc.) and optionally a size (SwitchSm, SwitchLg).
+// x.Readonly() keeps the value posted but prevents toggling.
+func Switch(args ...x.InputArg) x.Node {
+	// Container label makes the whole control clickable; disabled state read via :has
+	container := "inline-flex items-center cursor-pointer select-none relative has-[:disabled]:cursor-not-allowed has-[:disabled]:opacity-50 has-[[aria-readonly=true]]:pointer-events-none"
+	// Hidden native input covers the track to drive state and accessibility
+	inputCls := "absolute inset-0 m-0 size-full opacity-0 cursor-[inherit]"
+	// Visual track and thumb; state driven by sibling selectors
+	track := "switch-track inline-flex shrink-0 items-center rounded-full border border-transparent bg-input dark:bg-input/80 p-px shadow-xs transition-colors"
+	thumb := "switch-thumb pointer-events-none block translate-x-0 rounded-full bg-background dark:bg-foreground shadow-sm ring-0 transition-transform motion-reduce:transition-none"
+	// State styles: checked, focus-visible
+	states := " [&>input:checked~.switch-track]:bg-primary dark:[&>input:checked~.switch-track_.switch-thumb]:bg-primary-foreground [&>input:focus-visible~.switch-track]:ring-[3px] [&>input:focus-visible~.switch-track]:ring-ring/50"
+
+	inputArgs := []x.InputArg{
+		x.Class(inputCls),
+		x.InputType("checkbox"),
+		x.Role("switch"),
+	}
+
+	sizeCls := ""
+	readonly := false
+	for _, a := range args {
+		switch v := a.(type) {
+		case switchSizeArg:
+			if sizeCls == "" {
+				sizeCls = v.cls
+			}
+			continue
+		case x.ReadonlyOpt:
+			// Checkboxes ignore readonly natively; block toggling but keep the value submitted
+			readonly = true
+			inputArgs = append(inputArgs, x.Aria("readonly", "true"))
+		}
+		inputArgs = append(inputArgs, a)
+	}
+
+	if sizeCls == "" {
+		sizeCls = SwitchDefaultSize().(switchSizeArg).cls
+	}
+
+	sw := x.FormLabel(
+		x.Class(container+" "+sizeCls+states),
+		x.Data("slot", "switch"),
+		x.Input(inputArgs...),
+		x.Span(x.Class(track), x.Span(x.Class(thumb))),
+	)
+	if readonly {
+		return sw.WithAssets("", switchJS, "switch")
+	}
+	return sw
+}
@@ -1,11 +1,136 @@
 package ui
 
-import x "github.com/plainkit/html"
+import (
+	"strconv"
 
+	x "github.com/plainkit/html"
+)
+
+const textareaJS = `(function(){
+  // browsers with field-sizing already grow the textarea in CSS; the rest are sized here
+  const native = window.CSS && CSS.supports && CSS.supports('field-sizing', 'content');
+
+  function chrome(cs){
+    return parseFloat(cs.paddingTop)+parseFloat(cs.paddingBottom)+parseFloat(cs.borderTopWidth)+parseFloat(cs.borderBottomWidth);
+  }
+
+  function limit(el){
+    const rows = parseInt(el.dataset.maxRows||'0', 10);
+    if(!rows) return;
+    const cs = getComputedStyle(el);
+    const lh = parseFloat(cs.lineHeight) || parseFloat(cs.fontSize)*1.5;
+    el.style.maxHeight = (rows*lh+chrome(cs))+'px';
+    el.style.overflowY = 'auto';
+  }
+
+  function grow(el){
+    if(native) return;
+    const cs = getComputedStyle(el);
+    el.style.height = 'auto';
+    el.style.height = (el.scrollHeight+parseFloat(cs.borderTopWidth)+parseFloat(cs.borderBottomWidth))+'px';
+  }
+
+  function count(el){
+    const counter = el.dataset.counter && document.getElementById(el.dataset.counter);
+    if(!counter) return;
+    const words = el.dataset.countWords==='true';
+    const n = words ? (el.value.trim().match(/\S+/g)||[]).length : el.value.length;
+    const max = parseInt(el.dataset.counterMax||'0', 10);
+    const unit = words ? ' words' : ' characters';
+    counter.textContent = max ? n+' / '+max+unit : n+unit;
+    if(max && n>max) counter.dataset.over = 'true'; else delete counter.dataset.over;
+    // maxlength already stops extra characters; word limits are enforced through validity
+    if(words && max) el.setCustomValidity(n>max ? 'Please use at most '+max+' words.' : '');
+  }
+
+  function update(el){ grow(el); count(el); }
+
+  function init(){
+    document.querySelectorAll('textarea[data-slot="textarea"]').forEach(el=>{ limit(el); update(el); });
+  }
+
+  document.addEventListener('input', e=>{
+    if(e.target instanceof HTMLTextAreaElement && e.target.dataset.slot==='textarea') update(e.target);
+  });
+  window.addEventListener('resize', ()=>document.querySelectorAll('textarea[data-slot="textarea"]').forEach(grow));
+
+  if(document.readyState==='loading'){
+    document.addEventListener('DOMContentLoaded', init);
+  }else{
+    init();
+  }
+})();`
+
+// Internal wrappers so Textarea can pick its own options out of x.TextareaArg
+type textareaCounterArg struct {
+	x.Global
+	id  string
+	max int
+}
+
+type textareaCountWordsArg struct{ x.Global }
+
+// TextareaMaxRows stops auto-growing after n rows and scrolls beyond that
+func TextareaMaxRows(n int) x.TextareaArg {
+	return x.Data("max-rows", strconv.Itoa(n))
+}
+
+// TextareaCounter adds a live counter below the textarea with the given id, linked through
+// aria-describedby. A max > 0 also sets maxlength (or the word limit with TextareaCountWords).
+func TextareaCounter(id string, max int) x.TextareaArg {
+	return textareaCounterArg{Global: x.Class(""), id: id, max: max}
+}
+
+// TextareaCountWords makes TextareaCounter count words instead of characters
+func TextareaCountWords() x.TextareaArg {
+	return textareaCountWordsArg{Global: x.Data("count-words", "true")}
+}
+
+// Textarea renders a styled textarea that grows with its content (field-sizing where supported,
+// a small asset elsewhere). Pass textarea attributes via x.TextareaArg and the content via x.T.
 func Textarea(args ...x.TextareaArg) x.Node {
 	classes := "border-input placeholder:text-muted-foreground focus-visible:border-ring focus-visible:ring-ring/50 aria-invalid:ring-destructive/20 dark:aria-invalid:ring-destructive/40 aria-invalid:border-destructive dark:bg-input/30 flex field-sizing-content min-h-16 w-full rounded-md border bg-transparent px-3 py-2 text-base shadow-xs transition-[color,box-shadow] outline-none focus-visible:ring-[3px] disabled:cursor-not-allowed disabled:opacity-50 md:text-sm"
-	textareaArgs := []x.TextareaArg{x.Class(classes)}
-	textareaArgs = append(textareaArgs, args...)
+	textareaArgs := []x.TextareaArg{x.Class(classes), x.Data("slot", "textarea")}
+
+	var counter *textareaCounterArg
+	var words bool
+	for _, a := range args {
+		switch v := a.(type) {
+		case textareaCounterArg:
+			counter = &v
+			continue
+		case textareaCountWordsArg:
+			words = true
+		}
+		textareaArgs = append(textareaArgs, a)
+	}
+
+	if counter == nil {
+		return x.Textarea(textareaArgs...).WithAssets("", textareaJS, "textarea")
+	}
+
+	// Inserted ahead of the caller's args so an explicit aria-describedby (listing the counter id) wins
+	textareaArgs = append(textareaArgs[:2], append([]x.TextareaArg{x.Aria("describedby", counter.id), x.Data("counter", counter.id)}, textareaArgs[2:]...)...)
+	// Shown until the asset replaces it with the live count
+	hint := ""
+	if counter.max > 0 {
+		textareaArgs = append(textareaArgs, x.Data("counter-max", strconv.Itoa(counter.max)))
+		if words {
+			hint = "Up to " + strconv.Itoa(counter.max) + " words"
+		} else {
+			textareaArgs = append(textareaArgs, x.Maxlength(counter.max))
+			hint = "Up to " + strconv.Itoa(counter.max) + " characters"
+		}
+	}
 
-	return x.Textarea(textareaArgs...)
+	return x.Div(
+		x.Class("grid w-full gap-1.5"),
+		x.Child(x.Textarea(textareaArgs...)),
+		x.Child(x.P(
+			x.Id(counter.id),
+			x.Class("text-right text-xs text-muted-foreground tabular-nums data-[over=true]:text-destructive"),
+			x.Data("slot", "textarea-counter"),
+			x.T(hint),
+		)),
+	).WithAssets("", textareaJS, "textarea")
 }
@@ -0,0 +1,468 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	x "github.com/plainkit/html"
+)
+
+const timeInputJS = `(function(){
+  const pad = n=>String(n).padStart(2,'0');
+  const segment = el=>el && el.closest ? el.closest('[data-segment]') : null;
+  const siblings = seg=>Array.from(seg.closest('[data-slot="time-input"]').querySelectorAll('[data-segment]'));
+
+  function setValue(seg, v){
+    seg.value = seg.dataset.segment==='period' ? v : pad(v);
+    if(seg.dataset.segment==='period') seg.setAttribute('aria-valuetext', v);
+    else seg.setAttribute('aria-valuenow', String(v));
+    seg.dispatchEvent(new Event('change', {bubbles:true}));
+  }
+
+  function focusSegment(seg){ if(seg){ seg.focus(); seg.select(); } }
+
+  document.addEventListener('keydown', e=>{
+    const seg = segment(e.target);
+    if(!seg) return;
+    const segs = siblings(seg), i = segs.indexOf(seg);
+    if(e.key==='ArrowRight' && seg.selectionEnd===seg.value.length){ e.preventDefault(); focusSegment(segs[i+1]); return; }
+    if(e.key==='ArrowLeft' && seg.selectionStart===0){ e.preventDefault(); focusSegment(segs[i-1]); return; }
+    if(e.key==='Backspace' && seg.value==='' ){ e.preventDefault(); focusSegment(segs[i-1]); return; }
+
+    if(seg.dataset.segment==='period'){
+      if(e.key==='ArrowUp' || e.key==='ArrowDown'){ e.preventDefault(); setValue(seg, seg.value==='AM'? 'PM' : 'AM'); }
+      else if(/^[ap]$/i.test(e.key)){ e.preventDefault(); setValue(seg, e.key.toUpperCase()+'M'); }
+      return;
+    }
+
+    if(e.key==='ArrowUp' || e.key==='ArrowDown'){
+      e.preventDefault();
+      const min = parseInt(seg.dataset.min, 10), max = parseInt(seg.dataset.max, 10);
+      const step = parseInt(seg.dataset.step||'1', 10);
+      let v = parseInt(seg.value, 10);
+      if(isNaN(v)) v = min;
+      else v += e.key==='ArrowUp' ? step : -step;
+      // wrap around like a clock
+      if(v>max) v = min;
+      if(v<min) v = max;
+      setValue(seg, v);
+      seg.select();
+    }
+  });
+
+  document.addEventListener('input', e=>{
+    const seg = segment(e.target);
+    if(!seg || seg.dataset.segment==='period') return;
+    seg.value = seg.value.replace(/\D/g, '').slice(-2);
+    if(seg.value==='') return;
+    const max = parseInt(seg.dataset.max, 10), v = parseInt(seg.value, 10);
+    // advance once no further digit could fit
+    if(seg.value.length===2 || v*10>max){
+      setValue(seg, Math.min(v, max));
+      const segs = siblings(seg);
+      focusSegment(segs[segs.indexOf(seg)+1]);
+    }
+  });
+
+  document.addEventListener('focusin', e=>{ const seg = segment(e.target); if(seg) seg.select(); });
+})();`
+
+// defaultTimezones is offered by TimeInputTimezone when no zones are given
+var defaultTimezones = []string{
+	"UTC",
+	"America/Los_Angeles",
+	"America/Denver",
+	"America/Chicago",
+	"America/New_York",
+	"America/Sao_Paulo",
+	"Europe/London",
+	"Europe/Paris",
+	"Europe/Berlin",
+	"Africa/Johannesburg",
+	"Asia/Dubai",
+	"Asia/Kolkata",
+	"Asia/Shanghai",
+	"Asia/Tokyo",
+	"Australia/Sydney",
+}
+
+type timeInputOpts struct {
+	value      time.Time
+	seconds    bool
+	twelveHour bool
+	minuteStep int
+	timezones  []string
+	location   *time.Location
+}
+
+// Internal wrapper so TimeInput can pick its own options out of x.DivArg
+type timeInputArg struct {
+	x.Global
+	apply func(*timeInputOpts)
+}
+
+func newTimeInputArg(apply func(*timeInputOpts)) x.DivArg {
+	return timeInputArg{Global: x.Class(""), apply: apply}
+}
+
+// TimeInputValue sets the initial time (and, with TimeInputTimezone, the selected zone)
+func TimeInputValue(t time.Time) x.DivArg {
+	return newTimeInputArg(func(o *timeInputOpts) { o.value = t })
+}
+
+// TimeInputSeconds adds a seconds segment
+func TimeInputSeconds() x.DivArg {
+	return newTimeInputArg(func(o *timeInputOpts) { o.seconds = true })
+}
+
+// TimeInput12Hour shows hours 1–12 with an AM/PM segment
+func TimeInput12Hour() x.DivArg {
+	return newTimeInputArg(func(o *timeInputOpts) { o.twelveHour = true })
+}
+
+// TimeInputMinuteStep sets how far the arrow keys move the minutes segment (default 1)
+func TimeInputMinuteStep(n int) x.DivArg {
+	return newTimeInputArg(func(o *timeInputOpts) { o.minuteStep = n })
+}
+
+// TimeInputTimezone adds a timezone select listing IANA zones (a common set when none are given)
+func TimeInputTimezone(zones ...string) x.DivArg {
+	return newTimeInputArg(func(o *timeInputOpts) {
+		o.timezones = zones
+		if len(zones) == 0 {
+			o.timezones = defaultTimezones
+		}
+	})
+}
+
+// TimeInputLocation preselects loc in the timezone select when no TimeInputValue is given.
+// Pass the same loc to ParseTimeInput; it applies when the zone can't be named (e.g. time.Local
+// without TZ or /etc/localtime), in which case the select offers a "Default timezone" option.
+func TimeInputLocation(loc *time.Location) x.DivArg {
+	return newTimeInputArg(func(o *timeInputOpts) { o.location = loc })
+}
+
+// TimeInput renders segmented hour/minute (and optional second and AM/PM) fields with
+// arrow-key increments and auto-advance. Segments post as name.hour, name.minute,
+// name.second, name.period and the zone as name.tz; read them back with ParseTimeInput.
+func TimeInput(name string, args ...x.DivArg) x.Node {
+	o, rootArgs := timeInputOptions(args)
+	return o.render(name, rootArgs)
+}
+
+// DateTimeInput combines a DatePicker (posted as name.date) with a TimeInput for the same name.
+// Calendar, DatePicker and TimeInput options are forwarded; read the result with ParseDateTimeInput.
+func DateTimeInput(name string, args ...x.DivArg) x.Node {
+	var timeArgs, dateArgs, rootArgs []x.DivArg
+	for _, a := range args {
+		switch a.(type) {
+		case timeInputArg:
+			timeArgs = append(timeArgs, a)
+		case calendarArg, datePickerArg:
+			dateArgs = append(dateArgs, a)
+		default:
+			rootArgs = append(rootArgs, a)
+		}
+	}
+
+	o, _ := timeInputOptions(timeArgs)
+	if !o.value.IsZero() {
+		dateArgs = append(dateArgs, DatePickerValue(o.value))
+	}
+
+	dtArgs := []x.DivArg{
+		x.Class("flex flex-wrap items-center gap-2"),
+		x.Data("slot", "date-time-input"),
+		DatePicker(name+".date", dateArgs...),
+		o.render(name, nil),
+	}
+	dtArgs = append(dtArgs, rootArgs...)
+	return x.Div(dtArgs...)
+}
+
+func timeInputOptions(args []x.DivArg) (timeInputOpts, []x.DivArg) {
+	o := timeInputOpts{minuteStep: 1}
+	var rootArgs []x.DivArg
+	for _, a := range args {
+		if t, ok := a.(timeInputArg); ok {
+			t.apply(&o)
+			continue
+		}
+		rootArgs = append(rootArgs, a)
+	}
+	return o, rootArgs
+}
+
+func (o timeInputOpts) render(name string, rootArgs []x.DivArg) x.Node {
+	hasValue := !o.value.IsZero()
+	hour, minHour, maxHour := o.value.Hour(), 0, 23
+	period := "AM"
+	if o.twelveHour {
+		minHour, maxHour = 1, 12
+		if hour >= 12 {
+			period = "PM"
+		}
+		hour = hour % 12
+		if hour == 0 {
+			hour = 12
+		}
+	}
+
+	group := []x.DivArg{
+		x.Class("flex h-9 w-fit items-center rounded-md border border-input bg-background dark:bg-input/30 px-3 text-base shadow-xs transition-[color,box-shadow] focus-within:border-ring focus-within:ring-[3px] focus-within:ring-ring/50 has-[:disabled]:opacity-50 md:text-sm"),
+		x.Role("group"),
+		x.Data("slot", "time-input"),
+		timeSegment(name, "hour", "Hours", hour, minHour, maxHour, 1, hasValue),
+		timeSeparator(":"),
+		timeSegment(name, "minute", "Minutes", o.value.Minute(), 0, 59, o.minuteStep, hasValue),
+	}
+	if o.seconds {
+		group = append(group, timeSeparator(":"), timeSegment(name, "second", "Seconds", o.value.Second(), 0, 59, 1, hasValue))
+	}
+	if o.twelveHour {
+		group = append(group, timeSeparator(" "), x.Input(
+			x.Class(timeSegmentClass+" w-[3ch]"),
+			x.InputName(name+".period"),
+			x.InputValue(period),
+			x.Readonly(),
+			x.Role("spinbutton"),
+			x.Aria("label", "AM/PM"),
+			x.Aria("valuetext", period),
+			x.Data("segment", "period"),
+		))
+	}
+	group = append(group, rootArgs...)
+
+	node := x.Div(group...)
+	if len(o.timezones) > 0 {
+		node = x.Div(
+			x.Class("flex items-center gap-2"),
+			node,
+			o.timezoneSelect(name),
+		)
+	}
+	return node.WithAssets("", timeInputJS, "time-input")
+}
+
+const timeSegmentClass = "w-[2ch] rounded-sm bg-transparent p-0 text-center tabular-nums caret-transparent outline-none placeholder:text-muted-foreground focus:bg-accent focus:text-accent-foreground disabled:cursor-not-allowed"
+
+func timeSegment(name, segment, label string, value, min, max, step int, hasValue bool) x.Node {
+	segArgs := []x.InputArg{
+		x.Class(timeSegmentClass),
+		x.InputType("text"),
+		x.InputName(name + "." + segment),
+		x.InputMode("numeric"),
+		x.Custom("autocomplete", "off"),
+		x.Placeholder("--"),
+		x.Maxlength(2),
+		x.Role("spinbutton"),
+		x.Aria("label", label),
+		x.Aria("valuemin", strconv.Itoa(min)),
+		x.Aria("valuemax", strconv.Itoa(max)),
+		x.Data("segment", segment),
+		x.Data("min", strconv.Itoa(min)),
+		x.Data("max", strconv.Itoa(max)),
+	}
+	if step > 1 {
+		segArgs = append(segArgs, x.Data("step", strconv.Itoa(step)))
+	}
+	if hasValue {
+		segArgs = append(segArgs,
+			x.InputValue(fmt.Sprintf("%02d", value)),
+			x.Aria("valuenow", strconv.Itoa(value)),
+		)
+	}
+	return x.Input(segArgs...)
+}
+
+func timeSeparator(s string) x.Node {
+	return x.Span(x.Class("px-0.5 text-muted-foreground"), x.Aria("hidden", "true"), x.T(s))
+}
+
+func (o timeInputOpts) timezoneSelect(name string) x.Node {
+	loc := o.location
+	if !o.value.IsZero() {
+		loc = o.value.Location()
+	}
+	current := timeZoneName(loc)
+
+	selectArgs := []x.SelectArg{
+		x.Class("w-auto"),
+		x.Custom("name", name+".tz"),
+		x.Aria("label", "Timezone"),
+	}
+	listed := false
+	for _, tz := range o.timezones {
+		listed = listed || tz == current
+	}
+	if !listed {
+		value, label := current, strings.ReplaceAll(current, "_", " ")
+		if current == "" {
+			value, label = timeInputDefaultZone, "Default timezone"
+		}
+		selectArgs = append(selectArgs, x.Child(x.Option(x.Custom("value", value), x.Selected(), x.T(label))))
+	}
+	for _, tz := range o.timezones {
+		optionArgs := []x.OptionArg{x.Custom("value", tz), x.T(strings.ReplaceAll(tz, "_", " "))}
+		if tz == current {
+			optionArgs = append(optionArgs, x.Selected())
+		}
+		selectArgs = append(selectArgs, x.Child(x.Option(optionArgs...)))
+	}
+	return Select(selectArgs...)
+}
+
+// timeInputDefaultZone is posted when the zone couldn't be named; ParseTimeInput then uses its loc
+const timeInputDefaultZone = "default"
+
+// timeZoneName returns the IANA name of loc, or "" when it can't be determined. time.Local
+// reports "Local", so its name is looked up once from TZ or the /etc/localtime symlink.
+func timeZoneName(loc *time.Location) string {
+	if loc == nil {
+		return ""
+	}
+	if name := loc.String(); name != "Local" {
+		return name
+	}
+	localZoneOnce.Do(func() { localZone = lookupLocalZone() })
+	return localZone
+}
+
+var (
+	localZoneOnce sync.Once
+	localZone     string
+)
+
+func lookupLocalZone() string {
+	if tz, ok := os.LookupEnv("TZ"); ok {
+		tz = strings.TrimPrefix(tz, ":")
+		if tz == "" {
+			return "UTC"
+		}
+		if _, err := time.LoadLocation(tz); err == nil {
+			return tz
+		}
+		return ""
+	}
+	if target, err := filepath.EvalSymlinks("/etc/localtime"); err == nil {
+		if _, name, ok := strings.Cut(target, "zoneinfo/"); ok {
+			if _, err := time.LoadLocation(name); err == nil {
+				return name
+			}
+		}
+	}
+	return ""
+}
+
+// ErrTimeInputMissing is returned when no time was posted for a TimeInput
+var ErrTimeInputMissing = errors.New("ui: time input is empty")
+
+// ParseTimeInput reads the segments posted by TimeInput name and returns that clock time on
+// January 1 of year 0, in the posted name.tz zone or else loc. Only the zones the select offered
+// are accepted: pass the ones given to TimeInputTimezone (none means its default set); loc's own
+// zone is always allowed.
+func ParseTimeInput(values url.Values, name string, loc *time.Location, zones ...string) (time.Time, error) {
+	hour, min, sec, err := parseTimeSegments(values, name)
+	if err != nil {
+		return time.Time{}, err
+	}
+	l, err := timeInputLocation(values, name, loc, zones)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Date(0, time.January, 1, hour, min, sec, 0, l), nil
+}
+
+// ParseDateTimeInput reads the date and time posted by DateTimeInput name and returns them as a
+// single time.Time, accepting the zone as ParseTimeInput does.
+func ParseDateTimeInput(values url.Values, name string, loc *time.Location, zones ...string) (time.Time, error) {
+	raw := values.Get(name + ".date")
+	if raw == "" {
+		return time.Time{}, ErrTimeInputMissing
+	}
+	date, err := time.Parse("2006-01-02", raw)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("ui: invalid date %q: %w", raw, err)
+	}
+	hour, min, sec, err := parseTimeSegments(values, name)
+	if err != nil {
+		return time.Time{}, err
+	}
+	l, err := timeInputLocation(values, name, loc, zones)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Date(date.Year(), date.Month(), date.Day(), hour, min, sec, 0, l), nil
+}
+
+func parseTimeSegments(values url.Values, name string) (hour, min, sec int, err error) {
+	if values.Get(name+".hour") == "" && values.Get(name+".minute") == "" {
+		return 0, 0, 0, ErrTimeInputMissing
+	}
+
+	segment := func(key string, max int) (int, error) {
+		raw := values.Get(name + "." + key)
+		if raw == "" {
+			return 0, nil
+		}
+		n, err := strconv.Atoi(raw)
+		if err != nil || n < 0 || n > max {
+			return 0, fmt.Errorf("ui: invalid %s %q", key, raw)
+		}
+		return n, nil
+	}
+
+	if hour, err = segment("hour", 23); err != nil {
+		return
+	}
+	if min, err = segment("minute", 59); err != nil {
+		return
+	}
+	if sec, err = segment("second", 59); err != nil {
+		return
+	}
+
+	switch strings.ToUpper(values.Get(name + ".period")) {
+	case "AM":
+		if hour < 1 || hour > 12 {
+			return 0, 0, 0, fmt.Errorf("ui: invalid 12-hour hour %d", hour)
+		}
+		hour %= 12
+	case "PM":
+		if hour < 1 || hour > 12 {
+			return 0, 0, 0, fmt.Errorf("ui: invalid 12-hour hour %d", hour)
+		}
+		hour = hour%12 + 12
+	}
+	return
+}
+
+// timeInputLocation resolves the posted zone, rejecting any the select didn't offer
+func timeInputLocation(values url.Values, name string, loc *time.Location, zones []string) (*time.Location, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+	tz := values.Get(name + ".tz")
+	if tz == "" || tz == timeInputDefaultZone || tz == timeZoneName(loc) {
+		return loc, nil
+	}
+	if len(zones) == 0 {
+		zones = defaultTimezones
+	}
+	for _, z := range zones {
+		if z == tz {
+			if l, err := time.LoadLocation(tz); err == nil {
+				return l, nil
+			}
+			break
+		}
+	}
+	return nil, fmt.Errorf("ui: invalid timezone %q", tz)
+}
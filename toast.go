@@ -0,0 +1,181 @@
+package ui
+
+import (
+	"strconv"
+	"time"
+
+	x "github.com/plainkit/html"
+	"github.com/plainkit/icons/lucide"
+)
+
+const toastJS = `(function(){
+  const timers = new WeakMap();
+
+  function dismiss(toast){
+    if(toast.dataset.state==='closed') return;
+    toast.dataset.state = 'closed';
+    setTimeout(()=>toast.remove(), 200);
+  }
+
+  // each toast keeps its remaining time so pausing and resuming doesn't restart the clock
+  function start(toast){
+    const left = timers.has(toast) ? timers.get(toast).left : parseInt(toast.dataset.duration||'5000', 10);
+    if(!left) return;
+    const t = {left: left, since: Date.now()};
+    t.id = setTimeout(()=>dismiss(toast), left);
+    timers.set(toast, t);
+  }
+
+  function pause(toast){
+    const t = timers.get(toast);
+    if(!t || t.id===null) return;
+    clearTimeout(t.id);
+    t.left = Math.max(0, t.left-(Date.now()-t.since));
+    t.id = null;
+  }
+
+  const toasts = toaster=>toaster.querySelectorAll('[data-slot="toast"]');
+
+  function init(toaster){
+    if(toaster.dataset.ready) return;
+    toaster.dataset.ready = 'true';
+    toasts(toaster).forEach(start);
+    const pauseAll = ()=>toasts(toaster).forEach(pause);
+    const resumeAll = ()=>{ if(!toaster.matches(':hover') && !toaster.contains(document.activeElement)) toasts(toaster).forEach(start); };
+    toaster.addEventListener('mouseenter', pauseAll);
+    toaster.addEventListener('mouseleave', resumeAll);
+    toaster.addEventListener('focusin', pauseAll);
+    toaster.addEventListener('focusout', ()=>setTimeout(resumeAll));
+
+    // toasts added later (e.g. swapped in by htmx) start their own timers
+    new MutationObserver(list=>list.forEach(m=>m.addedNodes.forEach(n=>{
+      if(n.nodeType===1 && n.dataset.slot==='toast') start(n);
+    }))).observe(toaster, {childList: true});
+
+    // horizontal swipe past the threshold dismisses; shorter drags snap back
+    let drag = null;
+    toaster.addEventListener('pointerdown', e=>{
+      const toast = e.target.closest('[data-slot="toast"]');
+      if(!toast || e.target.closest('button,a')) return;
+      drag = {toast: toast, x: e.clientX, dx: 0};
+      toast.setPointerCapture(e.pointerId);
+      toast.dataset.swipe = 'move';
+    });
+    toaster.addEventListener('pointermove', e=>{
+      if(!drag) return;
+      drag.dx = Math.max(0, e.clientX-drag.x);
+      drag.toast.style.transform = 'translateX('+drag.dx+'px)';
+    });
+    const end = ()=>{
+      if(!drag) return;
+      const toast = drag.toast;
+      delete toast.dataset.swipe;
+      if(drag.dx>toast.offsetWidth/3){ toast.style.transform = 'translateX(100%)'; dismiss(toast); }
+      else toast.style.transform = '';
+      drag = null;
+    };
+    toaster.addEventListener('pointerup', end);
+    toaster.addEventListener('pointercancel', end);
+  }
+
+  document.addEventListener('click', e=>{
+    const btn = e.target.closest && e.target.closest('[data-toast-close]');
+    if(btn) dismiss(btn.closest('[data-slot="toast"]'));
+  });
+
+  function initAll(){ document.querySelectorAll('[data-slot="toaster"]').forEach(init); }
+  if(document.readyState==='loading'){
+    document.addEventListener('DOMContentLoaded', initAll);
+  }else{
+    initAll();
+  }
+})();`
+
+// Toaster renders the fixed notification region toasts are stacked in. The newest three are
+// shown; hovering the region expands the stack and pauses every timer. Pass toasts via x.Child.
+func Toaster(args ...x.DivArg) x.Node {
+	classes := "pointer-events-none fixed right-0 bottom-0 z-[100] flex max-h-screen w-full flex-col gap-2 p-4 sm:max-w-[420px] [&>[data-slot=toast]:nth-last-child(n+4)]:hidden hover:[&>[data-slot=toast]]:flex"
+	toasterArgs := []x.DivArg{
+		x.Class(classes),
+		x.Role("region"),
+		x.Aria("label", "Notifications"),
+		x.Aria("live", "polite"),
+		x.Data("slot", "toaster"),
+	}
+	toasterArgs = append(toasterArgs, args...)
+
+	return x.Div(toasterArgs...).WithAssets("", toastJS, "toast")
+}
+
+// Internal wrappers so Toast can tell variants apart and pick the right live role
+type toastVariantArg struct {
+	x.Global
+	alert bool
+}
+
+// Variants
+
+func ToastDefault() x.DivArg {
+	s := "border bg-background text-foreground"
+	return toastVariantArg{Global: x.Class(s)}
+}
+
+func ToastSuccess() x.DivArg {
+	s := "border-emerald-500/50 bg-emerald-50 text-emerald-900 dark:bg-emerald-950 dark:text-emerald-100"
+	return toastVariantArg{Global: x.Class(s)}
+}
+
+func ToastDestructive() x.DivArg {
+	s := "border-destructive bg-destructive text-white"
+	return toastVariantArg{Global: x.Class(s), alert: true}
+}
+
+// ToastDuration sets how long the toast stays before dismissing itself (default 5s); 0 keeps it until closed
+func ToastDuration(d time.Duration) x.DivArg {
+	return x.Data("duration", strconv.FormatInt(d.Milliseconds(), 10))
+}
+
+// Toast renders a single notification with a close button. Place it inside a Toaster and pass
+// ToastTitle/ToastDescription (or any content) via x.Child. Destructive toasts use role="alert".
+func Toast(args ...x.DivArg) x.Node {
+	classes := "pointer-events-auto relative grid w-full touch-pan-y gap-1 overflow-hidden rounded-md border p-4 pr-8 shadow-lg transition-all duration-200 starting:translate-y-2 starting:opacity-0 data-[state=closed]:opacity-0 data-[swipe=move]:transition-none"
+	toastArgs := []x.DivArg{x.Class(classes), x.Data("slot", "toast"), x.Data("state", "open")}
+
+	role := "status"
+	var hasVariant bool
+	for _, a := range args {
+		if v, ok := a.(toastVariantArg); ok {
+			hasVariant = true
+			if v.alert {
+				role = "alert"
+			}
+		}
+	}
+	if !hasVariant {
+		toastArgs = append(toastArgs, ToastDefault())
+	}
+	toastArgs = append(toastArgs, x.Role(role), x.Aria("atomic", "true"))
+	toastArgs = append(toastArgs, args...)
+
+	toastArgs = append(toastArgs, x.Child(x.Button(
+		x.Class("absolute top-2 right-2 rounded-md p-1 opacity-70 transition-opacity hover:opacity-100 focus-visible:outline-none focus-visible:ring-1 focus-visible:ring-ring"),
+		x.ButtonType("button"),
+		x.Aria("label", "Dismiss notification"),
+		x.Data("toast-close", ""),
+		x.Child(lucide.X(lucide.Size("14"))),
+	)))
+
+	return x.Div(toastArgs...)
+}
+
+// ToastTitle renders the toast heading
+func ToastTitle(args ...x.DivArg) x.Node {
+	titleArgs := append([]x.DivArg{x.Class("text-sm font-semibold")}, args...)
+	return x.Div(titleArgs...)
+}
+
+// ToastDescription renders the toast body text
+func ToastDescription(args ...x.DivArg) x.Node {
+	descArgs := append([]x.DivArg{x.Class("text-sm opacity-90")}, args...)
+	return x.Div(descArgs...)
+}
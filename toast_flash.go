@@ -0,0 +1,163 @@
+package ui
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	x "github.com/plainkit/html"
+)
+
+// toastCookie holds toasts queued by FlashToast until the next page renders them
+const toastCookie = "ui_toasts"
+
+// toastCookieMax bounds the encoded queue, leaving room for the cookie's name and attributes
+// under the 4096 bytes browsers accept; larger cookies are dropped whole
+const toastCookieMax = 3800
+
+// ToastMessage is a toast queued across a redirect with FlashToast.
+// Variant is "success", "destructive" or empty for the default style.
+type ToastMessage struct {
+	Title       string        `json:"t,omitempty"`
+	Description string        `json:"d,omitempty"`
+	Variant     string        `json:"v,omitempty"`
+	Duration    time.Duration `json:"dur,omitempty"`
+}
+
+// FlashToast queues a toast in a short-lived cookie, typically right before redirecting after a
+// form post. Several calls in one handler accumulate; FlashToaster renders them on the next page.
+// The queue must fit in a cookie, so the oldest toasts are dropped when it grows too large, and a
+// single toast that doesn't fit on its own has its text shortened.
+func FlashToast(w http.ResponseWriter, r *http.Request, msg ToastMessage) {
+	queued := append(pendingToasts(w, r), msg)
+	for {
+		raw, err := json.Marshal(queued)
+		if err != nil {
+			return
+		}
+		if value := base64.RawURLEncoding.EncodeToString(raw); len(value) <= toastCookieMax {
+			setToastCookie(w, value, 300)
+			return
+		}
+		switch short, ok := queued[0].shortened(); {
+		case len(queued) > 1:
+			queued = queued[1:]
+		case ok:
+			queued[0] = short
+		default:
+			return
+		}
+	}
+}
+
+// shortened halves the longer of the title and description, marking the cut with an ellipsis;
+// it reports false once there is nothing left to cut
+func (m ToastMessage) shortened() (ToastMessage, bool) {
+	text := &m.Description
+	if len(m.Title) > len(m.Description) {
+		text = &m.Title
+	}
+	runes := []rune(*text)
+	if len(runes) < 2 {
+		return m, false
+	}
+	*text = string(runes[:len(runes)/2]) + "…"
+	return m, true
+}
+
+// PopToasts returns the toasts queued for this request and clears the cookie
+func PopToasts(w http.ResponseWriter, r *http.Request) []ToastMessage {
+	queued := pendingToasts(w, r)
+	if len(queued) > 0 {
+		setToastCookie(w, "", -1)
+	}
+	return queued
+}
+
+// FlashToaster renders a Toaster holding every toast queued with FlashToast and clears the queue.
+// Call it before anything is written to w, since it sets a cookie.
+func FlashToaster(w http.ResponseWriter, r *http.Request, args ...x.DivArg) x.Node {
+	toasterArgs := append([]x.DivArg{}, args...)
+	for _, msg := range PopToasts(w, r) {
+		toasterArgs = append(toasterArgs, x.Child(msg.Toast()))
+	}
+	return Toaster(toasterArgs...)
+}
+
+// Toast renders the queued message as a Toast
+func (m ToastMessage) Toast() x.Node {
+	var toastArgs []x.DivArg
+	switch m.Variant {
+	case "success":
+		toastArgs = append(toastArgs, ToastSuccess())
+	case "destructive":
+		toastArgs = append(toastArgs, ToastDestructive())
+	}
+	if m.Duration != 0 {
+		toastArgs = append(toastArgs, ToastDuration(m.Duration))
+	}
+	if m.Title != "" {
+		toastArgs = append(toastArgs, x.Child(ToastTitle(x.T(m.Title))))
+	}
+	if m.Description != "" {
+		toastArgs = append(toastArgs, x.Child(ToastDescription(x.T(m.Description))))
+	}
+	return Toast(toastArgs...)
+}
+
+// pendingToasts reads the queue, preferring a cookie already set on w during this handler
+// over the one the request arrived with
+func pendingToasts(w http.ResponseWriter, r *http.Request) []ToastMessage {
+	value, found := "", false
+	for _, h := range w.Header().Values("Set-Cookie") {
+		if strings.HasPrefix(h, toastCookie+"=") {
+			value, _, _ = strings.Cut(strings.TrimPrefix(h, toastCookie+"="), ";")
+			found = true
+		}
+	}
+	if !found {
+		c, err := r.Cookie(toastCookie)
+		if err != nil {
+			return nil
+		}
+		value = c.Value
+	}
+	if value == "" {
+		return nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil
+	}
+	var queued []ToastMessage
+	if json.Unmarshal(raw, &queued) != nil {
+		return nil
+	}
+	return queued
+}
+
+// setToastCookie replaces any toast cookie already set on w so only the latest queue is sent
+func setToastCookie(w http.ResponseWriter, value string, maxAge int) {
+	var kept []string
+	for _, h := range w.Header().Values("Set-Cookie") {
+		if !strings.HasPrefix(h, toastCookie+"=") {
+			kept = append(kept, h)
+		}
+	}
+	w.Header().Del("Set-Cookie")
+	for _, h := range kept {
+		w.Header().Add("Set-Cookie", h)
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     toastCookie,
+		Value:    value,
+		Path:     "/",
+		MaxAge:   maxAge,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
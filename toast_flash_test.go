@@ -0,0 +1,64 @@
+package ui
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// flashed replays the Set-Cookie of a FlashToast response on the next request
+func flashed(t *testing.T, rec *httptest.ResponseRecorder) []ToastMessage {
+	t.Helper()
+	// browsers drop cookies over 4096 bytes, attributes included
+	for _, h := range rec.Header().Values("Set-Cookie") {
+		if len(h) > 4096 {
+			t.Fatalf("toast cookie is %d bytes", len(h))
+		}
+	}
+	next := httptest.NewRequest(http.MethodGet, "/", nil)
+	for _, c := range rec.Result().Cookies() {
+		next.AddCookie(c)
+	}
+	return PopToasts(httptest.NewRecorder(), next)
+}
+
+func TestFlashToastQueue(t *testing.T) {
+	rec := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodPost, "/", nil)
+	FlashToast(rec, r, ToastMessage{Title: "Saved"})
+	FlashToast(rec, r, ToastMessage{Title: "Sent", Variant: "success"})
+
+	got := flashed(t, rec)
+	if len(got) != 2 || got[0].Title != "Saved" || got[1].Variant != "success" {
+		t.Fatalf("queue = %+v", got)
+	}
+}
+
+func TestFlashToastDropsOldest(t *testing.T) {
+	rec := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodPost, "/", nil)
+	long := strings.Repeat("<long & escaped> ", 60)
+	for i := 0; i < 10; i++ {
+		FlashToast(rec, r, ToastMessage{Title: string(rune('a' + i)), Description: long})
+	}
+
+	got := flashed(t, rec)
+	if len(got) == 0 || len(got) == 10 {
+		t.Fatalf("kept %d toasts", len(got))
+	}
+	if last := got[len(got)-1]; last.Title != "j" || last.Description != long {
+		t.Fatalf("newest toast not kept whole: %+v", last)
+	}
+}
+
+func TestFlashToastShortensOversized(t *testing.T) {
+	rec := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodPost, "/", nil)
+	FlashToast(rec, r, ToastMessage{Title: "Import failed", Description: strings.Repeat("é", 5000)})
+
+	got := flashed(t, rec)
+	if len(got) != 1 || got[0].Title != "Import failed" || !strings.HasSuffix(got[0].Description, "…") {
+		t.Fatalf("oversized toast = %+v", got)
+	}
+}
@@ -0,0 +1,205 @@
+package ui
+
+import x "github.com/plainkit/html"
+
+const toggleJS = `(function(){
+  const items = group=>Array.from(group.querySelectorAll('[data-slot="toggle-group-item"]')).filter(b=>b.closest('[data-slot="toggle-group"]')===group);
+  const enabled = group=>items(group).filter(b=>!b.disabled);
+  const pressed = b=>b.getAttribute('aria-pressed')==='true';
+
+  function press(btn, on){
+    btn.setAttribute('aria-pressed', on ? 'true' : 'false');
+    btn.dataset.state = on ? 'on' : 'off';
+  }
+
+  // one tab stop per group: current if given, else the first pressed item, else the first item
+  function rove(group, current){
+    const list = enabled(group);
+    current = current || list.find(pressed) || list[0];
+    list.forEach(b=>b.setAttribute('tabindex', b===current ? '0' : '-1'));
+  }
+
+  // pressed values post as hidden inputs under the group name
+  function sync(group){
+    const name = group.dataset.name;
+    if(!name) return;
+    group.querySelectorAll(':scope > input[data-toggle-value]').forEach(i=>i.remove());
+    items(group).filter(pressed).forEach(b=>{
+      const input = document.createElement('input');
+      input.type = 'hidden';
+      input.name = name;
+      input.value = b.dataset.value||'';
+      input.dataset.toggleValue = '';
+      group.appendChild(input);
+    });
+  }
+
+  document.addEventListener('click', e=>{
+    const btn = e.target.closest && e.target.closest('[data-slot="toggle"],[data-slot="toggle-group-item"]');
+    if(!btn || btn.disabled) return;
+    const group = btn.dataset.slot==='toggle-group-item' && btn.closest('[data-slot="toggle-group"]');
+    const on = !pressed(btn);
+    if(group && on && group.dataset.type!=='multiple') items(group).forEach(b=>press(b, false));
+    press(btn, on);
+    if(group){
+      sync(group);
+      rove(group, btn);
+      group.dispatchEvent(new Event('change', {bubbles:true}));
+    }
+  });
+
+  document.addEventListener('keydown', e=>{
+    const btn = e.target.closest && e.target.closest('[data-slot="toggle-group-item"]');
+    const group = btn && btn.closest('[data-slot="toggle-group"]');
+    if(!group) return;
+    const list = enabled(group), idx = list.indexOf(btn);
+    const vertical = group.getAttribute('data-orientation')==='vertical';
+    let next = null;
+    if(e.key===(vertical ? 'ArrowDown' : 'ArrowRight')) next = list[(idx+1)%list.length];
+    else if(e.key===(vertical ? 'ArrowUp' : 'ArrowLeft')) next = list[(idx-1+list.length)%list.length];
+    else if(e.key==='Home') next = list[0];
+    else if(e.key==='End') next = list[list.length-1];
+    if(next){
+      e.preventDefault();
+      rove(group, next);
+      next.focus();
+    }
+  });
+
+  function init(){
+    document.querySelectorAll('[data-slot="toggle-group"]').forEach(g=>{ sync(g); rove(g); });
+  }
+
+  if(document.readyState==='loading'){
+    document.addEventListener('DOMContentLoaded', init);
+  }else{
+    init();
+  }
+})();`
+
+// Internal wrappers embed x.Global so they are valid x.ButtonArg and keep their class string
+type toggleVariantArg struct {
+	x.Global
+	cls string
+}
+
+type toggleSizeArg struct {
+	x.Global
+	cls string
+}
+
+// Variants
+
+func ToggleGhost() x.ButtonArg {
+	s := "bg-transparent"
+	return toggleVariantArg{Global: x.Class(s), cls: s}
+}
+
+func ToggleOutline() x.ButtonArg {
+	s := "border border-input bg-transparent shadow-xs hover:bg-accent hover:text-accent-foreground"
+	return toggleVariantArg{Global: x.Class(s), cls: s}
+}
+
+// Sizes (prefixed)
+
+func ToggleDefaultSize() x.ButtonArg {
+	s := "h-9 min-w-9 px-2"
+	return toggleSizeArg{Global: x.Class(s), cls: s}
+}
+
+func ToggleSm() x.ButtonArg {
+	s := "h-8 min-w-8 px-1.5"
+	return toggleSizeArg{Global: x.Class(s), cls: s}
+}
+
+func ToggleLg() x.ButtonArg {
+	s := "h-10 min-w-10 px-2.5"
+	return toggleSizeArg{Global: x.Class(s), cls: s}
+}
+
+// Internal marker for the initial pressed state
+type togglePressedArg struct{ x.Global }
+
+// TogglePressed renders the toggle (or group item) pressed
+func TogglePressed() x.ButtonArg {
+	return togglePressedArg{Global: x.Data("state", "on")}
+}
+
+func toggleArgs(slot string, args []x.ButtonArg) []x.ButtonArg {
+	base := "inline-flex items-center justify-center gap-2 rounded-md text-sm font-medium whitespace-nowrap outline-none transition-[color,box-shadow] hover:bg-muted hover:text-muted-foreground focus-visible:border-ring focus-visible:ring-[3px] focus-visible:ring-ring/50 disabled:pointer-events-none disabled:opacity-50 aria-pressed:bg-accent aria-pressed:text-accent-foreground [&_svg]:pointer-events-none [&_svg]:shrink-0 [&_svg:not([class*='size-'])]:size-4"
+	btnArgs := make([]x.ButtonArg, 0, len(args)+7)
+	btnArgs = append(btnArgs, x.Class(base), x.ButtonType("button"), x.Data("slot", slot))
+
+	var hasVariant, hasSize, isPressed bool
+	for _, a := range args {
+		switch a.(type) {
+		case toggleVariantArg:
+			hasVariant = true
+		case toggleSizeArg:
+			hasSize = true
+		case togglePressedArg:
+			isPressed = true
+		}
+		btnArgs = append(btnArgs, a)
+	}
+
+	if !hasVariant {
+		btnArgs = append(btnArgs, ToggleGhost())
+	}
+	if !hasSize {
+		btnArgs = append(btnArgs, ToggleDefaultSize())
+	}
+	if isPressed {
+		btnArgs = append(btnArgs, x.Aria("pressed", "true"))
+	} else {
+		btnArgs = append(btnArgs, x.Aria("pressed", "false"), x.Data("state", "off"))
+	}
+	return btnArgs
+}
+
+// Toggle renders a two-state button exposing its state through aria-pressed.
+// Accepts a variant (ToggleGhost, ToggleOutline), a size and TogglePressed.
+func Toggle(args ...x.ButtonArg) x.Node {
+	return x.Button(toggleArgs("toggle", args)...).WithAssets("", toggleJS, "toggle")
+}
+
+// Internal marker for multiple selection
+type toggleGroupMultipleArg struct{ x.Global }
+
+// ToggleGroupMultiple lets any number of items be pressed (by default pressing one releases the others)
+func ToggleGroupMultiple() x.DivArg {
+	return toggleGroupMultipleArg{Global: x.Data("type", "multiple")}
+}
+
+// ToggleGroup wraps ToggleGroupItem children with roving focus (arrow keys, Home/End; pass
+// data-orientation="vertical" for up/down). Pressed values are posted as hidden inputs named name;
+// pass an empty name for a toolbar that only drives client-side behavior.
+func ToggleGroup(name string, args ...x.DivArg) x.Node {
+	groupArgs := []x.DivArg{
+		x.Class("inline-flex w-fit items-center gap-1 data-[orientation=vertical]:flex-col"),
+		x.Role("group"),
+		x.Data("slot", "toggle-group"),
+	}
+	if name != "" {
+		groupArgs = append(groupArgs, x.Data("name", name))
+	}
+	var multiple bool
+	for _, a := range args {
+		if _, ok := a.(toggleGroupMultipleArg); ok {
+			multiple = true
+		}
+	}
+	if !multiple {
+		groupArgs = append(groupArgs, x.Data("type", "single"))
+	}
+	groupArgs = append(groupArgs, args...)
+
+	return x.Div(groupArgs...).WithAssets("", toggleJS, "toggle")
+}
+
+// ToggleGroupItem renders a Toggle inside a ToggleGroup that submits value when pressed
+func ToggleGroupItem(value string, args ...x.ButtonArg) x.Node {
+	btnArgs := toggleArgs("toggle-group-item", args)
+	btnArgs = append(btnArgs, x.Data("value", value))
+	return x.Button(btnArgs...)
+}